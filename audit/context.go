@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Meta is the request information recorded with each audit entry.
+type Meta struct {
+	RequestID string
+	IP        string
+	Actor     *primitive.ObjectID
+}
+
+type metaKey struct{}
+
+// WithRequest stores the request id and client IP in ctx.
+func WithRequest(ctx context.Context, requestID string, ip string) context.Context {
+	meta := FromContext(ctx)
+	meta.RequestID = requestID
+	meta.IP = ip
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// WithActor stores the authenticated user in ctx.
+func WithActor(ctx context.Context, actor primitive.ObjectID) context.Context {
+	meta := FromContext(ctx)
+	meta.Actor = &actor
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// FromContext returns the request information stored in ctx, or a zero Meta
+// for calls that did not come through an HTTP request (e.g. scheduled jobs).
+func FromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	return meta
+}
@@ -24,6 +24,9 @@ func SetupServer() *gin.Engine {
 	config.AllowOrigins = []string{"http://localhost:3000"}
 	// config.AllowAllOrigins = true // 모든 오리진 허용
 	config.AllowCredentials = true
+	// 낙관적 동시성 제어용 헤더
+	config.AddAllowHeaders("If-Match")
+	config.AddExposeHeaders("ETag")
 	router.Use(cors.New(config))
 
 	return router
@@ -37,5 +40,9 @@ func RunServer(router *gin.Engine) {
 	routes.SetDependency(db)
 	routes.SetupRoutes(router)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	routes.StartJobs(ctx)
+
 	router.Run("localhost:8080")
 }
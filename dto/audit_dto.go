@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// AuditLogQueryDTO info
+// @Description 감사 로그 조회 조건, 최신순
+type AuditLogQueryDTO struct {
+	Resource   string    `form:"resource"`
+	ResourceID string    `form:"resource_id"`
+	Action     string    `form:"action"`
+	Actor      string    `form:"actor"`
+	RequestID  string    `form:"request_id"`
+	From       time.Time `form:"from"`
+	To         time.Time `form:"to"`
+	Limit      int       `form:"limit"`
+} //@name AuditLogQueryDTO
@@ -0,0 +1,48 @@
+package dto
+
+// RegisterDTO info
+// @Description 이메일/비밀번호 회원가입 dto
+type RegisterDTO struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+	UserName string `json:"user_name" validate:"required"`
+} //@name RegisterDTO
+
+// LoginDTO info
+// @Description 이메일/비밀번호 로그인 dto
+type LoginDTO struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+} //@name LoginDTO
+
+// VerifyEmailDTO info
+// @Description 이메일 인증 dto, 메일로 받은 토큰
+type VerifyEmailDTO struct {
+	Token string `json:"token" validate:"required"`
+} //@name VerifyEmailDTO
+
+// PasswordForgotDTO info
+// @Description 비밀번호 재설정 메일 요청 dto
+type PasswordForgotDTO struct {
+	Email string `json:"email" validate:"required,email"`
+} //@name PasswordForgotDTO
+
+// PasswordResetDTO info
+// @Description 비밀번호 재설정 dto, 메일로 받은 토큰과 새 비밀번호
+type PasswordResetDTO struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+} //@name PasswordResetDTO
+
+// MFAVerifyDTO info
+// @Description 로그인 2단계 인증 dto, mfa_token이 없으면 쿠키 사용, code는 TOTP 코드 또는 복구 코드
+type MFAVerifyDTO struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code" validate:"required"`
+} //@name MFAVerifyDTO
+
+// TOTPCodeDTO info
+// @Description TOTP 등록 확인/해제, 복구 코드 재발급 dto
+type TOTPCodeDTO struct {
+	Code string `json:"code" validate:"required"`
+} //@name TOTPCodeDTO
@@ -0,0 +1,25 @@
+package dto
+
+// CandidateCreateDTO info
+// @Description 지원자 생성 dto, 중복 의심 지원자가 있으면 응답의 possible_duplicates로 반환
+type CandidateCreateDTO struct {
+	Name  string   `json:"name"`
+	Email string   `json:"email,omitempty"`
+	Phone string   `json:"phone,omitempty"`
+	Links []string `json:"links,omitempty"`
+} //@name CandidateCreateDTO
+
+// CandidateUpdateDTO info
+// @Description 지원자 수정 dto, links를 전달하면 전체를 교체
+type CandidateUpdateDTO struct {
+	Name  string   `json:"name,omitempty"`
+	Email string   `json:"email,omitempty"`
+	Phone string   `json:"phone,omitempty"`
+	Links []string `json:"links,omitempty"`
+} //@name CandidateUpdateDTO
+
+// CandidateMergeDTO info
+// @Description 지원자 병합 dto, source 지원자의 정보와 지원 이력을 대상 지원자로 옮기고 source를 삭제
+type CandidateMergeDTO struct {
+	Source string `json:"source"`
+} //@name CandidateMergeDTO
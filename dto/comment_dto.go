@@ -0,0 +1,28 @@
+package dto
+
+// CommentCreateDTO info
+// @Description 댓글 생성 dto, target_type은 project_task, todo, job_application 중 하나이며 content의 @이름으로 유저를 멘션
+type CommentCreateDTO struct {
+	TargetType string `json:"target_type"`
+	Target     string `json:"target"`
+	Content    string `json:"content"`
+} //@name CommentCreateDTO
+
+// CommentUpdateDTO info
+// @Description 댓글 수정 dto
+type CommentUpdateDTO struct {
+	Content string `json:"content"`
+} //@name CommentUpdateDTO
+
+// CommentQueryDTO info
+// @Description 댓글 조회 조건
+type CommentQueryDTO struct {
+	TargetType string `form:"target_type"`
+	Target     string `form:"target"`
+} //@name CommentQueryDTO
+
+// NotificationQueryDTO info
+// @Description 알림 조회 조건
+type NotificationQueryDTO struct {
+	Unread bool `form:"unread"`
+} //@name NotificationQueryDTO
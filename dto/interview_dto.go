@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// InterviewCreateDTO info
+// @Description 면접 일정 생성 dto, 면접관은 Meeting 참석자로 등록되고 title이 없으면 지원자 정보로 생성
+type InterviewCreateDTO struct {
+	Title        string    `json:"title,omitempty"`
+	Interviewers []string  `json:"interviewers"`
+	StartDt      time.Time `json:"start_dt"`
+	EndDt        time.Time `json:"end_dt,omitempty"`
+	Location     string    `json:"location,omitempty"`
+	Notes        string    `json:"notes,omitempty"`
+} //@name InterviewCreateDTO
+
+// InterviewOutcomeDTO info
+// @Description 면접 결과 기록 dto (pending/passed/failed/no_show)
+type InterviewOutcomeDTO struct {
+	Outcome string `json:"outcome"`
+	Note    string `json:"note,omitempty"`
+} //@name InterviewOutcomeDTO
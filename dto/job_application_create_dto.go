@@ -5,14 +5,23 @@ import "time"
 // JobApplicationCreateDTO info
 // @Description JobApplication information create dto
 type JobApplicationCreateDTO struct {
-	ApplicantName string    `json:"applicant_name"`
-	User          string    `json:"manager"`
-	Department    string    `json:"department,omitempty"`
-	Position      string    `json:"position"`
-	Task          string    `json:"task"`
-	Stage         string    `json:"stage"`
-	Location      string    `json:"location,omitempty"`
-	Status        string    `json:"status"`
-	StartDt       time.Time `json:"start_dt"`
-	EndDt         time.Time `json:"end_dt,omitempty"`
+	ApplicantName string          `json:"applicant_name"`
+	Candidate     string          `json:"candidate,omitempty"`
+	User          string          `json:"manager"`
+	Department    string          `json:"department,omitempty"`
+	Position      string          `json:"position"`
+	Task          string          `json:"task"`
+	Stage         string          `json:"stage"`
+	Location      string          `json:"location,omitempty"`
+	Status        string          `json:"status"`
+	StartDt       time.Time       `json:"start_dt"`
+	EndDt         time.Time       `json:"end_dt,omitempty"`
+	Attachments   []AttachmentDTO `json:"attachments,omitempty"`
 } //@name JobApplicationCreateDTO
+
+// AttachmentDTO info
+// @Description JobApplication 첨부 파일 dto
+type AttachmentDTO struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+} //@name AttachmentDTO
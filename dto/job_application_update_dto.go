@@ -5,14 +5,24 @@ import "time"
 // JobApplicationUpdateDTO info
 // @Description JobApplication information update dto
 type JobApplicationUpdateDTO struct {
-	ApplicantName string    `json:"applicant_name"`
-	User          string    `json:"manager"`
-	Department    string    `json:"department,omitempty"`
-	Position      string    `json:"position"`
-	Task          string    `json:"task"`
-	Stage         string    `json:"stage"`
-	Location      string    `json:"location,omitempty"`
-	Status        string    `json:"status"`
-	StartDt       time.Time `json:"start_dt"`
-	EndDt         time.Time `json:"end_dt,omitempty"`
+	ApplicantName string          `json:"applicant_name"`
+	Candidate     string          `json:"candidate,omitempty"`
+	User          string          `json:"manager"`
+	Department    string          `json:"department,omitempty"`
+	Position      string          `json:"position"`
+	Task          string          `json:"task"`
+	Stage         string          `json:"stage"`
+	Location      string          `json:"location,omitempty"`
+	Status        string          `json:"status"`
+	StartDt       time.Time       `json:"start_dt"`
+	EndDt         time.Time       `json:"end_dt,omitempty"`
+	Attachments   []AttachmentDTO `json:"attachments,omitempty"`
 } //@name JobApplicationUpdateDTO
+
+// RecruitingFunnelQueryDTO info
+// @Description 채용 퍼널 조회 조건, start_dt 기준 [from, to) 기간과 부서
+type RecruitingFunnelQueryDTO struct {
+	From       time.Time `form:"from"`
+	To         time.Time `form:"to"`
+	Department string    `form:"department"`
+} //@name RecruitingFunnelQueryDTO
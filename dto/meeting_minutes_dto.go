@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// MeetingMinutesDTO info
+// @Description Meeting 회의록 dto
+type MeetingMinutesDTO struct {
+	AgendaItems []AgendaItemDTO `json:"agenda_items,omitempty"`
+	Decisions   []string        `json:"decisions,omitempty"`
+	ActionItems []ActionItemDTO `json:"action_items,omitempty"`
+} //@name MeetingMinutesDTO
+
+// AgendaItemDTO info
+// @Description 회의 안건 dto
+type AgendaItemDTO struct {
+	Title string `json:"title"`
+	Notes string `json:"notes,omitempty"`
+} //@name AgendaItemDTO
+
+// ActionItemDTO info
+// @Description 회의 액션 아이템 dto, 기존 아이템을 유지하려면 id를 함께 전달
+type ActionItemDTO struct {
+	ID          string     `json:"id,omitempty"`
+	Description string     `json:"description"`
+	Assignee    string     `json:"assignee,omitempty"`
+	DueDt       *time.Time `json:"due_dt,omitempty"`
+} //@name ActionItemDTO
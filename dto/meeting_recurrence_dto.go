@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// RecurrenceDTO info
+// @Description Meeting 반복 규칙 dto
+type RecurrenceDTO struct {
+	Freq     string     `json:"freq"`
+	Interval int        `json:"interval,omitempty"`
+	ByDay    []string   `json:"by_day,omitempty"`
+	Until    *time.Time `json:"until,omitempty"`
+	Count    int        `json:"count,omitempty"`
+	TimeZone string     `json:"time_zone,omitempty"`
+} //@name RecurrenceDTO
+
+// MeetingOccurrenceDTO info
+// @Description 반복 Meeting 특정 회차 취소/변경 dto
+type MeetingOccurrenceDTO struct {
+	OriginalStartDt time.Time  `json:"original_start_dt"`
+	Cancelled       bool       `json:"cancelled,omitempty"`
+	Title           string     `json:"title,omitempty"`
+	Description     string     `json:"description,omitempty"`
+	Location        string     `json:"location,omitempty"`
+	StartDt         *time.Time `json:"start_dt,omitempty"`
+	EndDt           *time.Time `json:"end_dt,omitempty"`
+} //@name MeetingOccurrenceDTO
+
+// MeetingQueryDTO info
+// @Description Meeting 조회 조건 (기간, 사용자, 역할(creator/participant), 시점(upcoming/past))
+type MeetingQueryDTO struct {
+	From time.Time `form:"from"`
+	To   time.Time `form:"to"`
+	User string    `form:"user"`
+	Role string    `form:"role"`
+	When string    `form:"when"`
+} //@name MeetingQueryDTO
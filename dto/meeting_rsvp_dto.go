@@ -0,0 +1,21 @@
+package dto
+
+// MeetingRSVPDTO info
+// @Description Meeting 참석 응답 dto, 응답자는 로그인한 유저
+type MeetingRSVPDTO struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment,omitempty"`
+} //@name MeetingRSVPDTO
+
+// MeetingAttendanceDTO info
+// @Description Meeting 출석 기록 dto
+type MeetingAttendanceDTO struct {
+	Attendances []Attendance `json:"attendances"`
+} //@name MeetingAttendanceDTO
+
+// Attendance info
+// @Description 참석자별 출석 여부
+type Attendance struct {
+	Participant string `json:"participant"`
+	Attended    bool   `json:"attended"`
+} //@name Attendance
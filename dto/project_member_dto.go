@@ -0,0 +1,8 @@
+package dto
+
+// ProjectMemberDTO info
+// @Description Project 멤버 추가/역할 변경 dto
+type ProjectMemberDTO struct {
+	User string `json:"user"`
+	Role string `json:"role"`
+} //@name ProjectMemberDTO
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// ProjectMilestoneDTO info
+// @Description Project 마일스톤 생성/수정 dto, 수정 시 tasks를 전달하면 연결된 Task 목록을 교체
+type ProjectMilestoneDTO struct {
+	Name  string    `json:"name"`
+	DueDt time.Time `json:"due_dt"`
+	Tasks []string  `json:"tasks,omitempty"`
+} //@name ProjectMilestoneDTO
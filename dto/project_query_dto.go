@@ -0,0 +1,13 @@
+package dto
+
+// ProjectQueryDTO info
+// @Description Project 목록 조회 조건
+type ProjectQueryDTO struct {
+	IncludeArchived bool `form:"include_archived"`
+} //@name ProjectQueryDTO
+
+// ProjectDeleteDTO info
+// @Description Project 삭제 방식, restrict(기본값)는 하위 Task/Todo가 있으면 거부하고 cascade는 함께 삭제
+type ProjectDeleteDTO struct {
+	Mode string `form:"mode"`
+} //@name ProjectDeleteDTO
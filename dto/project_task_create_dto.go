@@ -1,11 +1,17 @@
 package dto
 
+import "time"
+
 // ProjectTaskCreateDTO info
 // @Description ProjectTask information create dto
 type ProjectTaskCreateDTO struct {
-	Project         string `json:"project"`
-	Manager         string `json:"manager,omitempty"`
-	Department      string `json:"department,omitempty"`
-	TaskDescription string `json:"task_description,omitempty"`
-	Status          string `json:"status"`
+	Project         string     `json:"project"`
+	Manager         string     `json:"manager,omitempty"`
+	Department      string     `json:"department,omitempty"`
+	TaskDescription string     `json:"task_description,omitempty"`
+	Status          string     `json:"status"`
+	StartDt         *time.Time `json:"start_dt,omitempty"`
+	EndDt           *time.Time `json:"end_dt,omitempty"`
+	BlockedBy       []string   `json:"blocked_by,omitempty"`
+	EstimateMinutes int64      `json:"estimate_minutes,omitempty"`
 } //@name ProjectTaskCreateDTO
@@ -1,10 +1,16 @@
 package dto
 
+import "time"
+
 // ProjectTaskUpdateDTO info
 // @Description ProjectTask information update dto
 type ProjectTaskUpdateDTO struct {
-	Manager         string `json:"manager,omitempty"`
-	Department      string `json:"department,omitempty"`
-	TaskDescription string `json:"task_description,omitempty"`
-	Status          string `json:"status"`
+	Manager         string     `json:"manager,omitempty"`
+	Department      string     `json:"department,omitempty"`
+	TaskDescription string     `json:"task_description,omitempty"`
+	Status          string     `json:"status"`
+	StartDt         *time.Time `json:"start_dt,omitempty"`
+	EndDt           *time.Time `json:"end_dt,omitempty"`
+	BlockedBy       []string   `json:"blocked_by,omitempty"`
+	EstimateMinutes int64      `json:"estimate_minutes,omitempty"`
 } //@name ProjectTaskUpdateDTO
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// ProjectTemplateCreateDTO info
+// @Description Project를 템플릿으로 저장하는 dto
+type ProjectTemplateCreateDTO struct {
+	Project string `json:"project"`
+	Name    string `json:"name"`
+} //@name ProjectTemplateCreateDTO
+
+// ProjectTemplateInstantiateDTO info
+// @Description 템플릿으로 Project를 생성하는 dto, managers는 템플릿 담당자 ID -> 새 담당자 ID
+type ProjectTemplateInstantiateDTO struct {
+	Name     string            `json:"name"`
+	StartDt  time.Time         `json:"start_dt"`
+	Managers map[string]string `json:"managers,omitempty"`
+} //@name ProjectTemplateInstantiateDTO
@@ -0,0 +1,25 @@
+package dto
+
+// RecruitmentPipelineCreateDTO info
+// @Description 채용 파이프라인 생성 dto, 부서당 하나만 생성 가능
+type RecruitmentPipelineCreateDTO struct {
+	Department string             `json:"department"`
+	Name       string             `json:"name"`
+	Stages     []PipelineStageDTO `json:"stages"`
+} //@name RecruitmentPipelineCreateDTO
+
+// RecruitmentPipelineUpdateDTO info
+// @Description 채용 파이프라인 수정 dto, stages를 전달하면 단계 전체를 교체
+type RecruitmentPipelineUpdateDTO struct {
+	Name   string             `json:"name,omitempty"`
+	Stages []PipelineStageDTO `json:"stages,omitempty"`
+} //@name RecruitmentPipelineUpdateDTO
+
+// PipelineStageDTO info
+// @Description 채용 단계 dto, next는 이동 가능한 단계 key 목록
+type PipelineStageDTO struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Terminal bool     `json:"terminal,omitempty"`
+	Next     []string `json:"next,omitempty"`
+} //@name PipelineStageDTO
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// RetentionPolicyDTO info
+// @Description 지원 결과별 보존 기간 설정 dto
+type RetentionPolicyDTO struct {
+	RetentionDays int `json:"retention_days"`
+} //@name RetentionPolicyDTO
+
+// ErasureLogQueryDTO info
+// @Description 개인정보 삭제 기록 조회 조건, erased_at 기준 [from, to) 기간과 사유(retention/manual)
+type ErasureLogQueryDTO struct {
+	From   time.Time `form:"from"`
+	To     time.Time `form:"to"`
+	Reason string    `form:"reason"`
+} //@name ErasureLogQueryDTO
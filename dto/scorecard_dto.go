@@ -0,0 +1,50 @@
+package dto
+
+// ScorecardTemplateCreateDTO info
+// @Description 평가표 템플릿 생성 dto, 포지션당 하나만 생성 가능
+type ScorecardTemplateCreateDTO struct {
+	Position string                  `json:"position"`
+	Name     string                  `json:"name"`
+	Criteria []ScorecardCriterionDTO `json:"criteria"`
+} //@name ScorecardTemplateCreateDTO
+
+// ScorecardTemplateUpdateDTO info
+// @Description 평가표 템플릿 수정 dto, criteria를 전달하면 항목 전체를 교체
+type ScorecardTemplateUpdateDTO struct {
+	Name     string                  `json:"name,omitempty"`
+	Criteria []ScorecardCriterionDTO `json:"criteria,omitempty"`
+} //@name ScorecardTemplateUpdateDTO
+
+// ScorecardCriterionDTO info
+// @Description 평가 항목 dto, 점수 범위가 없으면 1~5, weight가 없으면 1
+type ScorecardCriterionDTO struct {
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	ScaleMin    int     `json:"scale_min,omitempty"`
+	ScaleMax    int     `json:"scale_max,omitempty"`
+	Weight      float64 `json:"weight,omitempty"`
+} //@name ScorecardCriterionDTO
+
+// ScorecardSubmitDTO info
+// @Description 평가표 제출 dto, 이미 제출했다면 기존 평가표를 교체
+type ScorecardSubmitDTO struct {
+	Ratings        []ScorecardRatingDTO `json:"ratings"`
+	Recommendation string               `json:"recommendation"`
+	Notes          string               `json:"notes,omitempty"`
+} //@name ScorecardSubmitDTO
+
+// ScorecardRatingDTO info
+// @Description 평가 항목별 점수 dto
+type ScorecardRatingDTO struct {
+	Criterion string `json:"criterion"`
+	Score     int    `json:"score"`
+	Comment   string `json:"comment,omitempty"`
+} //@name ScorecardRatingDTO
+
+// HiringDecisionDTO info
+// @Description 채용 결정 dto (hire/reject/hold)
+type HiringDecisionDTO struct {
+	Decision string `json:"decision"`
+	Note     string `json:"note,omitempty"`
+} //@name HiringDecisionDTO
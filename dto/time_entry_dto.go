@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// TimeEntryStartDTO info
+// @Description 타이머 시작 dto, task와 todo 중 하나를 지정
+type TimeEntryStartDTO struct {
+	Task        string `json:"task,omitempty"`
+	Todo        string `json:"todo,omitempty"`
+	Description string `json:"description,omitempty"`
+} //@name TimeEntryStartDTO
+
+// TimeEntryCreateDTO info
+// @Description 작업 시간 직접 입력 dto, task와 todo 중 하나를 지정
+type TimeEntryCreateDTO struct {
+	Task        string    `json:"task,omitempty"`
+	Todo        string    `json:"todo,omitempty"`
+	Description string    `json:"description,omitempty"`
+	StartDt     time.Time `json:"start_dt"`
+	EndDt       time.Time `json:"end_dt"`
+} //@name TimeEntryCreateDTO
+
+// TimeEntryQueryDTO info
+// @Description 작업 시간 조회 기간
+type TimeEntryQueryDTO struct {
+	From time.Time `form:"from"`
+	To   time.Time `form:"to"`
+} //@name TimeEntryQueryDTO
+
+// TimeReportQueryDTO info
+// @Description 작업 시간 리포트 조회 조건, group_by는 project, user, department 중 하나
+type TimeReportQueryDTO struct {
+	From    time.Time `form:"from"`
+	To      time.Time `form:"to"`
+	GroupBy string    `form:"group_by"`
+	Format  string    `form:"format"`
+} //@name TimeReportQueryDTO
@@ -0,0 +1,7 @@
+package dto
+
+// TrashQueryDTO info
+// @Description 휴지통 조회 조건, 삭제 일시 최신순
+type TrashQueryDTO struct {
+	Resource string `form:"resource"`
+} //@name TrashQueryDTO
@@ -0,0 +1,26 @@
+package dto
+
+// UserProfileDTO info
+// @Description 내 프로필 수정 dto
+type UserProfileDTO struct {
+	UserName   string `json:"user_name,omitempty"`
+	Position   string `json:"position,omitempty"`
+	Department string `json:"department,omitempty"`
+	Photo      string `json:"photo,omitempty"`
+} //@name UserProfileDTO
+
+// UserDeleteDTO info
+// @Description 유저 삭제 조건, reassign_to가 있으면 Note/Todo/Meeting/Project Task/댓글/작업 시간/Project 소유권을 해당 유저에게 넘기고 없으면 삭제, 소유한 Project가 있으면 reassign_to 필수
+type UserDeleteDTO struct {
+	ReassignTo string `form:"reassign_to"`
+} //@name UserDeleteDTO
+
+// UserQueryDTO info
+// @Description 유저 목록/검색 조건, department는 하위 부서까지 포함
+type UserQueryDTO struct {
+	Q                  string `form:"q"`
+	Department         string `form:"department"`
+	Position           string `form:"position"`
+	IncludeDeactivated bool   `form:"include_deactivated"`
+	Limit              int    `form:"limit"`
+} //@name UserQueryDTO
@@ -7,8 +7,6 @@ type UserUpdateDTO struct {
 	Email      string `json:"email"`
 	UserName   string `json:"user_name"`
 	Position   string `json:"position,omitempty"`
-	Verified   *bool  `json:"verified,omitempty"`
-	Provider   string `json:"provider"`
 	Photo      string `json:"photo"`
 	Department string `json:"department,omitempty"`
 } //@name UserUpdateDTO
@@ -0,0 +1,56 @@
+package etag
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format returns the strong ETag for a document version.
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// Parse reads an If-Match header into the versions it accepts.
+// An empty header or "*" places no condition and returns nil.
+// Weak tags (W/"3") are compared like strong ones since the version is the whole tag.
+func Parse(header string) ([]int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	versions := []int64{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity tag %s", tag)
+		}
+
+		version, err := strconv.ParseInt(unquoted, 10, 64)
+		if err != nil || version < 0 {
+			return nil, fmt.Errorf("invalid entity tag %s", tag)
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+type ifMatchKey struct{}
+
+// WithIfMatch stores the versions accepted by the request's If-Match header in ctx.
+func WithIfMatch(ctx context.Context, versions []int64) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, versions)
+}
+
+// IfMatch returns the versions stored by WithIfMatch, or false when the
+// request did not send a conditional If-Match header.
+func IfMatch(ctx context.Context) ([]int64, bool) {
+	versions, _ := ctx.Value(ifMatchKey{}).([]int64)
+	return versions, len(versions) > 0
+}
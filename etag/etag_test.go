@@ -0,0 +1,68 @@
+package etag
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	if got := Format(3); got != `"3"` {
+		t.Errorf("got %s, want \"3\"", got)
+	}
+
+	// Format으로 만든 태그는 Parse로 같은 version이 나와야 함
+	versions, err := Parse(Format(42))
+	if err != nil || !reflect.DeepEqual(versions, []int64{42}) {
+		t.Errorf("round trip: got %v, %v", versions, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    []int64
+		wantErr bool
+	}{
+		{name: "empty", header: "", want: nil},
+		{name: "blank", header: "   ", want: nil},
+		{name: "any", header: "*", want: nil},
+		{name: "quoted", header: `"3"`, want: []int64{3}},
+		{name: "weak", header: `W/"3"`, want: []int64{3}},
+		{name: "zero", header: `"0"`, want: []int64{0}},
+		{name: "list", header: `"1", W/"2" ,"3"`, want: []int64{1, 2, 3}},
+		{name: "unquoted", header: `3`, wantErr: true},
+		{name: "not a number", header: `"abc"`, wantErr: true},
+		{name: "negative", header: `"-1"`, wantErr: true},
+		{name: "invalid entry in list", header: `"1", abc`, wantErr: true},
+		{name: "lowercase weak prefix", header: `w/"3"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	if _, ok := IfMatch(context.Background()); ok {
+		t.Error("no header: want ok false")
+	}
+
+	if _, ok := IfMatch(WithIfMatch(context.Background(), nil)); ok {
+		t.Error("wildcard: want ok false")
+	}
+
+	versions, ok := IfMatch(WithIfMatch(context.Background(), []int64{2, 3}))
+	if !ok || !reflect.DeepEqual(versions, []int64{2, 3}) {
+		t.Errorf("got %v, %v, want [2 3], true", versions, ok)
+	}
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	auditService services.AuditService
+}
+
+func NewAuditHandler(auditService services.AuditService) AuditHandler {
+	return AuditHandler{auditService}
+}
+
+// GetAuditLogs godoc
+// @Tags Audit
+// @Summary 감사 로그 조회
+// @Description 관리자용 변경 이력 조회, 최신순
+// @ID GetAuditLogs
+// @Accept  json
+// @Produce  json
+// @Param resource query string false "리소스 (note, todo, project, project_task, meeting, job_application, user)"
+// @Param resource_id query string false "리소스 ID"
+// @Param action query string false "작업 (create, update, delete)"
+// @Param actor query string false "변경한 User ID"
+// @Param request_id query string false "요청 ID (X-Request-ID)"
+// @Param from query string false "시작 일시 (RFC3339)"
+// @Param to query string false "종료 일시 (RFC3339)"
+// @Param limit query int false "최대 개수 (기본 100, 최대 1000)"
+// @Router /audit-logs [get]
+// @Success 200 {object} dto.APIResponse[[]AuditLog]
+// @Failure 500
+func (ah *AuditHandler) GetAuditLogs(ctx *gin.Context) {
+	var query dto.AuditLogQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	logs, err := ah.auditService.GetAuditLogs(&query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": logs})
+}
+
+// VerifyAuditLogs godoc
+// @Tags Audit
+// @Summary 감사 로그 검증
+// @Description hash 체인을 처음부터 다시 계산하고 서명된 체인 head와 비교하여 누락/변조/뒤쪽 삭제된 항목이 있는지 확인
+// @ID VerifyAuditLogs
+// @Accept  json
+// @Produce  json
+// @Router /audit-logs/verify [get]
+// @Success 200 {object} dto.APIResponse[AuditVerification]
+// @Failure 500
+func (ah *AuditHandler) VerifyAuditLogs(ctx *gin.Context) {
+	verification, err := ah.auditService.VerifyAuditLogs()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": verification})
+}
@@ -3,11 +3,13 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/Kim-DaeHan/all-note-golang/utils"
@@ -16,10 +18,11 @@ import (
 
 type AuthHandler struct {
 	userService services.UserService
+	authService services.AuthService
 }
 
-func NewAuthHandler(userService services.UserService) AuthHandler {
-	return AuthHandler{userService}
+func NewAuthHandler(userService services.UserService, authService services.AuthService) AuthHandler {
+	return AuthHandler{userService, authService}
 }
 
 func (ah *AuthHandler) GoogleOAuth(ctx *gin.Context) {
@@ -53,62 +56,42 @@ func (ah *AuthHandler) GoogleOAuth(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
 	}
 
-	fmt.Printf("user: %+v", user)
-
 	resBody := &dto.UserUpdateDTO{
 		Email:    user.Email,
 		UserName: user.Name,
 		Photo:    user.Picture,
-		Provider: "google",
 		GoogleID: user.Id,
-		Verified: &user.Verified_email,
-	}
-
-	updatedUser, err := ah.userService.UpsertUser(resBody)
-	if err != nil {
-		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
 	}
 
-	accessExpiredInStr := os.Getenv("ACCESS_TOKEN_EXPIRED_IN")
-	accessTokenExpiredIn, err := time.ParseDuration(accessExpiredInStr)
+	updatedUser, err := ah.userService.UpsertGoogleUser(ctx.Request.Context(), resBody, user.Verified_email)
 	if err != nil {
 		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+		return
 	}
 
-	refreshExpiredInStr := os.Getenv("REFRESH_TOKEN_EXPIRED_IN")
-	refreshTokenExpiredIn, err := time.ParseDuration(refreshExpiredInStr)
-	if err != nil {
-		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	if !updatedUser.IsActive() {
+		ctx.JSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user is deactivated"})
+		return
 	}
 
-	// Generate Tokens
-	access_token, err := utils.CreateToken(accessTokenExpiredIn, updatedUser.ID.Hex(), os.Getenv("ACCESS_TOKEN_JWT_SECRET"))
+	// 2단계 인증이 필요하면 토큰 대신 mfa_token 쿠키를 주고 클라이언트의 인증 코드 입력 페이지로 이동
+	challenge, err := ah.authService.Challenge(updatedUser)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		authFail(ctx, err)
 		return
 	}
 
-	refresh_token, err := utils.CreateToken(refreshTokenExpiredIn, updatedUser.ID.Hex(), os.Getenv("REFRESH_TOKEN_JWT_SECRET"))
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+	if challenge != nil {
+		setMFACookie(ctx, challenge.MFAToken)
+		ctx.Redirect(http.StatusTemporaryRedirect, fmt.Sprint(os.Getenv("CLIENT_ORIGIN"), "/mfa?state=", url.QueryEscape(pathUrl)))
 		return
 	}
-	accessTokenMaxAgeStr := os.Getenv("ACCESS_TOKEN_MAXAGE")
-	accessTokenMaxAge, err := strconv.Atoi(accessTokenMaxAgeStr)
-	if err != nil {
-		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
-	}
 
-	refreshTokenMaxAgeStr := os.Getenv("REFRESH_TOKEN_MAXAGE")
-	refreshTokenMaxAge, err := strconv.Atoi(refreshTokenMaxAgeStr)
-	if err != nil {
+	if _, err := issueTokens(ctx, updatedUser); err != nil {
 		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+		return
 	}
 
-	ctx.SetCookie("access_token", access_token, accessTokenMaxAge*60, "/", "localhost", false, true)
-	ctx.SetCookie("refresh_token", refresh_token, refreshTokenMaxAge*60, "/", "localhost", false, true)
-	ctx.SetCookie("logged_in", "true", accessTokenMaxAge*60, "/", "localhost", false, false)
-
 	ctx.Redirect(http.StatusTemporaryRedirect, fmt.Sprint(os.Getenv("CLIENT_ORIGIN"), pathUrl))
 }
 
@@ -122,7 +105,6 @@ func (ah *AuthHandler) LogoutUser(ctx *gin.Context) {
 
 func (ah *AuthHandler) GetMe(ctx *gin.Context) {
 	currentUser := ctx.MustGet("currentUser").(models.User)
-	fmt.Printf("user: %+v", currentUser)
 	ctx.JSON(http.StatusOK, currentUser)
 
 }
@@ -144,11 +126,22 @@ func (ah *AuthHandler) RefreshAccessToken(ctx *gin.Context) {
 	}
 
 	user, err := ah.userService.GetUser(sub.(string))
-	if err != nil {
+	if err != nil || user == nil {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user belonging to this token no logger exists"})
 		return
 	}
 
+	if !user.IsActive() {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user belonging to this token is deactivated"})
+		return
+	}
+
+	// 역할 정책상 2단계 인증이 필수인데 등록하지 않은 유저는 다시 로그인해야 함
+	if ah.authService.RequiresTOTP(user) && !user.HasTOTP() {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "two-factor authentication is required, please log in again"})
+		return
+	}
+
 	accessExpiredInStr := os.Getenv("ACCESS_TOKEN_EXPIRED_IN")
 	accessTokenExpiredIn, err := time.ParseDuration(accessExpiredInStr)
 	if err != nil {
@@ -171,3 +164,321 @@ func (ah *AuthHandler) RefreshAccessToken(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"status": "success", "access_token": access_token})
 }
+
+func (ah *AuthHandler) Register(ctx *gin.Context) {
+	var dto dto.RegisterDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	user, err := ah.authService.Register(&dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "message": "verification email sent", "data": user})
+}
+
+func (ah *AuthHandler) VerifyEmail(ctx *gin.Context) {
+	var dto dto.VerifyEmailDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	user, err := ah.authService.VerifyEmail(&dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "data": user})
+}
+
+func (ah *AuthHandler) Login(ctx *gin.Context) {
+	var dto dto.LoginDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	user, err := ah.authService.Login(&dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	if !user.IsActive() {
+		ctx.JSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user is deactivated"})
+		return
+	}
+
+	challenge, err := ah.authService.Challenge(user)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	if challenge != nil {
+		setMFACookie(ctx, challenge.MFAToken)
+		ctx.JSON(http.StatusOK, gin.H{"status": challenge.Status, "data": challenge})
+		return
+	}
+
+	access_token, err := issueTokens(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "access_token": access_token})
+}
+
+func (ah *AuthHandler) ForgotPassword(ctx *gin.Context) {
+	var dto dto.PasswordForgotDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	if err := ah.authService.ForgotPassword(&dto); err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	// 가입 여부와 관계없이 같은 응답
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "message": "if the email is registered, a reset link has been sent"})
+}
+
+func (ah *AuthHandler) ResetPassword(ctx *gin.Context) {
+	var dto dto.PasswordResetDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	if err := ah.authService.ResetPassword(&dto); err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// GetMFAChallenge mfa_token 쿠키의 2단계 인증 요청 조회, Google 로그인 후 등록이 필요한 경우 등록 정보 확인용
+func (ah *AuthHandler) GetMFAChallenge(ctx *gin.Context) {
+	mfaToken, _ := ctx.Cookie("mfa_token")
+
+	challenge, err := ah.authService.GetChallenge(mfaToken)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	setMFACookie(ctx, challenge.MFAToken)
+	ctx.JSON(http.StatusOK, gin.H{"status": challenge.Status, "data": challenge})
+}
+
+func (ah *AuthHandler) VerifyMFA(ctx *gin.Context) {
+	var dto dto.MFAVerifyDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	if dto.MFAToken == "" {
+		dto.MFAToken, _ = ctx.Cookie("mfa_token")
+	}
+
+	user, recoveryCodes, err := ah.authService.VerifyMFA(&dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	access_token, err := issueTokens(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	ctx.SetCookie("mfa_token", "", -1, "/", "localhost", false, true)
+
+	// 로그인 중에 등록을 마친 경우 복구 코드를 함께 전달
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "access_token": access_token, "recovery_codes": recoveryCodes})
+}
+
+func (ah *AuthHandler) EnrollTOTP(ctx *gin.Context) {
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	enrollment, err := ah.authService.EnrollTOTP(currentUser.ID.Hex())
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "data": enrollment})
+}
+
+func (ah *AuthHandler) ConfirmTOTP(ctx *gin.Context) {
+	var dto dto.TOTPCodeDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	recoveryCodes, err := ah.authService.ConfirmTOTP(currentUser.ID.Hex(), &dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "data": recoveryCodes})
+}
+
+func (ah *AuthHandler) RegenerateRecoveryCodes(ctx *gin.Context) {
+	var dto dto.TOTPCodeDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	recoveryCodes, err := ah.authService.RegenerateRecoveryCodes(currentUser.ID.Hex(), &dto)
+	if err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "data": recoveryCodes})
+}
+
+func (ah *AuthHandler) DisableTOTP(ctx *gin.Context) {
+	var dto dto.TOTPCodeDTO
+
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	if err := ah.authService.DisableTOTP(currentUser.ID.Hex(), &dto); err != nil {
+		authFail(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// setMFACookie 로그인 2단계용 mfa_token 쿠키 (5분)
+func setMFACookie(ctx *gin.Context, mfaToken string) {
+	ctx.SetCookie("mfa_token", mfaToken, 5*60, "/", "localhost", false, true)
+}
+
+// issueTokens access/refresh 토큰을 만들어 쿠키로 설정, Google/이메일 로그인 공통
+func issueTokens(ctx *gin.Context, user *models.User) (string, error) {
+	accessTokenExpiredIn, err := time.ParseDuration(os.Getenv("ACCESS_TOKEN_EXPIRED_IN"))
+	if err != nil {
+		return "", err
+	}
+
+	refreshTokenExpiredIn, err := time.ParseDuration(os.Getenv("REFRESH_TOKEN_EXPIRED_IN"))
+	if err != nil {
+		return "", err
+	}
+
+	accessTokenMaxAge, err := strconv.Atoi(os.Getenv("ACCESS_TOKEN_MAXAGE"))
+	if err != nil {
+		return "", err
+	}
+
+	refreshTokenMaxAge, err := strconv.Atoi(os.Getenv("REFRESH_TOKEN_MAXAGE"))
+	if err != nil {
+		return "", err
+	}
+
+	// Generate Tokens
+	access_token, err := utils.CreateToken(accessTokenExpiredIn, user.ID.Hex(), os.Getenv("ACCESS_TOKEN_JWT_SECRET"))
+	if err != nil {
+		return "", err
+	}
+
+	refresh_token, err := utils.CreateToken(refreshTokenExpiredIn, user.ID.Hex(), os.Getenv("REFRESH_TOKEN_JWT_SECRET"))
+	if err != nil {
+		return "", err
+	}
+
+	ctx.SetCookie("access_token", access_token, accessTokenMaxAge*60, "/", "localhost", false, true)
+	ctx.SetCookie("refresh_token", refresh_token, refreshTokenMaxAge*60, "/", "localhost", false, true)
+	ctx.SetCookie("logged_in", "true", accessTokenMaxAge*60, "/", "localhost", false, false)
+
+	return access_token, nil
+}
+
+// authFail 인증 API의 {"status": "fail"} 형식 오류 응답
+func authFail(ctx *gin.Context, err error) {
+	if customErr, ok := err.(*errors.CustomError); ok {
+		ctx.JSON(customErr.Status(), gin.H{"status": "fail", "message": customErr.Error()})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"status": "fail", "message": err.Error()})
+}
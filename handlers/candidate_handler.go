@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CandidateHandler struct {
+	candidateService services.CandidateService
+}
+
+func NewCandidateHandler(candidateService services.CandidateService) CandidateHandler {
+	return CandidateHandler{candidateService}
+}
+
+// GetAllCandidate godoc
+// @Tags Candidate
+// @Summary 전체 지원자 조회
+// @Description 전체 지원자 조회
+// @ID GetAllCandidate
+// @Accept  json
+// @Produce  json
+// @Router /candidates [get]
+// @Success 200 {object} dto.APIResponse[[]Candidate]
+// @Failure 500
+func (ch *CandidateHandler) GetAllCandidate(ctx *gin.Context) {
+	candidates, err := ch.candidateService.GetAllCandidate()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": candidates})
+}
+
+// GetCandidateProfile godoc
+// @Tags Candidate
+// @Summary 지원자 프로필 조회
+// @Description 지원자 정보와 최신순 지원 이력 조회
+// @ID GetCandidateProfile
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Router /candidates/{candidateId} [get]
+// @Success 200 {object} dto.APIResponse[CandidateProfile]
+// @Failure 500
+func (ch *CandidateHandler) GetCandidateProfile(ctx *gin.Context) {
+	candidateId := ctx.Param("id")
+
+	profile, err := ch.candidateService.GetCandidateProfile(candidateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	if profile != nil {
+		setETag(ctx, profile.Version)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": profile})
+}
+
+// GetCandidateDuplicates godoc
+// @Tags Candidate
+// @Summary 중복 의심 지원자 조회
+// @Description 이메일/전화번호가 같거나 이름이 비슷한 지원자를 유사도 순으로 조회
+// @ID GetCandidateDuplicates
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Router /candidates/{candidateId}/duplicates [get]
+// @Success 200 {object} dto.APIResponse[[]CandidateMatch]
+// @Failure 500
+func (ch *CandidateHandler) GetCandidateDuplicates(ctx *gin.Context) {
+	candidateId := ctx.Param("id")
+
+	duplicates, err := ch.candidateService.GetCandidateDuplicates(candidateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": duplicates})
+}
+
+// CreateCandidate godoc
+// @Tags Candidate
+// @Summary 지원자 생성
+// @Description 지원자 생성, 중복 의심 지원자가 있으면 possible_duplicates로 함께 반환
+// @ID CreateCandidate
+// @Accept  json
+// @Produce  json
+// @Param candidate body dto.CandidateCreateDTO true "지원자 정보"
+// @Router /candidates [post]
+// @Success 200 {object} dto.APIResponse[Candidate]
+// @Failure 500
+func (ch *CandidateHandler) CreateCandidate(ctx *gin.Context) {
+	var dto dto.CandidateCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	candidate, err := ch.candidateService.CreateCandidate(&dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": candidate})
+}
+
+// UpdateCandidate godoc
+// @Tags Candidate
+// @Summary 지원자 수정
+// @Description 지원자 수정
+// @ID UpdateCandidate
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Param candidate body dto.CandidateUpdateDTO true "지원자 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /candidates/{candidateId} [patch]
+// @Success 200 {object} dto.APIResponse[Candidate]
+// @Failure 500
+func (ch *CandidateHandler) UpdateCandidate(ctx *gin.Context) {
+	var dto dto.CandidateUpdateDTO
+	candidateId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	candidate, err := ch.candidateService.UpdateCandidate(ctx.Request.Context(), candidateId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, candidate.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": candidate})
+}
+
+// MergeCandidate godoc
+// @Tags Candidate
+// @Summary 지원자 병합
+// @Description source 지원자의 비어 있지 않은 정보와 지원 이력을 대상 지원자로 옮기고 source 삭제
+// @ID MergeCandidate
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Param merge body dto.CandidateMergeDTO true "병합할 지원자"
+// @Router /candidates/{candidateId}/merge [post]
+// @Success 200 {object} dto.APIResponse[CandidateProfile]
+// @Failure 500
+func (ch *CandidateHandler) MergeCandidate(ctx *gin.Context) {
+	var dto dto.CandidateMergeDTO
+	candidateId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	profile, err := ch.candidateService.MergeCandidate(candidateId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": profile})
+}
+
+// DeleteCandidate godoc
+// @Tags Candidate
+// @Summary 지원자 삭제
+// @Description 지원 이력이 없는 지원자 삭제
+// @ID DeleteCandidate
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Router /candidates/{candidateId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (ch *CandidateHandler) DeleteCandidate(ctx *gin.Context) {
+	candidateId := ctx.Param("id")
+
+	err := ch.candidateService.DeleteCandidate(candidateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CommentHandler struct {
+	commentService services.CommentService
+}
+
+func NewCommentHandler(commentService services.CommentService) CommentHandler {
+	return CommentHandler{commentService}
+}
+
+// GetCommentByTarget godoc
+// @Tags Comment
+// @Summary 댓글 조회
+// @Description 대상(Project Task, Todo, JobApplication)의 댓글 조회
+// @ID GetCommentByTarget
+// @Accept  json
+// @Produce  json
+// @Param target_type query string true "project_task, todo, job_application"
+// @Param target query string true "대상 ID"
+// @Router /comments [get]
+// @Success 200 {object} dto.APIResponse[[]Comment]
+// @Failure 500
+func (ch *CommentHandler) GetCommentByTarget(ctx *gin.Context) {
+	var query dto.CommentQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	comments, err := ch.commentService.GetCommentByTarget(&query, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": comments})
+}
+
+// CreateComment godoc
+// @Tags Comment
+// @Summary 댓글 생성
+// @Description 댓글 생성, 내용의 @이름(유저 이름 또는 이메일 아이디)으로 멘션된 유저에게 알림 생성
+// @ID CreateComment
+// @Accept  json
+// @Produce  json
+// @Param comment body dto.CommentCreateDTO true "댓글 정보"
+// @Router /comments [post]
+// @Success 200 {object} dto.APIResponse[Comment]
+// @Failure 500
+func (ch *CommentHandler) CreateComment(ctx *gin.Context) {
+	var dto dto.CommentCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	comment, err := ch.commentService.CreateComment(&dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": comment})
+}
+
+// UpdateComment godoc
+// @Tags Comment
+// @Summary 댓글 수정
+// @Description 댓글 수정, 작성자만 가능하며 새로 멘션된 유저에게만 알림 생성
+// @ID UpdateComment
+// @Accept  json
+// @Produce  json
+// @Param commentId path string true "Comment ID"
+// @Param comment body dto.CommentUpdateDTO true "댓글 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /comments/{commentId} [patch]
+// @Success 200 {object} dto.APIResponse[Comment]
+// @Failure 500
+func (ch *CommentHandler) UpdateComment(ctx *gin.Context) {
+	var dto dto.CommentUpdateDTO
+	commentId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	comment, err := ch.commentService.UpdateComment(ctx.Request.Context(), commentId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, comment.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": comment})
+}
+
+// DeleteComment godoc
+// @Tags Comment
+// @Summary 댓글 삭제
+// @Description 댓글 삭제, 작성자만 가능
+// @ID DeleteComment
+// @Accept  json
+// @Produce  json
+// @Param commentId path string true "Comment ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /comments/{commentId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (ch *CommentHandler) DeleteComment(ctx *gin.Context) {
+	commentId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := ch.commentService.DeleteComment(ctx.Request.Context(), commentId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
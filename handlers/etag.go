@@ -0,0 +1,11 @@
+package handlers
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/etag"
+	"github.com/gin-gonic/gin"
+)
+
+// setETag 응답한 문서의 version을 ETag 헤더로 보냄, 클라이언트는 수정/삭제할 때 If-Match로 돌려보냄
+func setETag(ctx *gin.Context, version int64) {
+	ctx.Header("ETag", etag.Format(version))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -46,6 +47,45 @@ func (jh *JobApplicationHandler) GetAllJobApplication(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": jobApplications})
 }
 
+// GetRecruitingFunnel godoc
+// @Tags JobApplication
+// @Summary 채용 퍼널 분석
+// @Description start_dt 기간 내 단계별 현재/도달 인원, 단계 간 전환율, 채용 소요 시간 중앙값, 부서/포지션/근무지별 현황 조회
+// @ID GetRecruitingFunnel
+// @Accept  json
+// @Produce  json
+// @Param from query string false "start_dt 조회 시작 일시(RFC3339)"
+// @Param to query string false "start_dt 조회 종료 일시(RFC3339)"
+// @Param department query string false "Department ID"
+// @Router /jobApplications/funnel [get]
+// @Success 200 {object} dto.APIResponse[RecruitingFunnel]
+// @Failure 500
+func (jh *JobApplicationHandler) GetRecruitingFunnel(ctx *gin.Context) {
+	var query dto.RecruitingFunnelQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	funnel, err := jh.jobApplicationService.GetRecruitingFunnel(&query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": funnel})
+}
+
 // GetJobApplication godoc
 // @Tags JobApplication
 // @Summary JobApplication 조회
@@ -75,6 +115,10 @@ func (jh *JobApplicationHandler) GetJobApplication(ctx *gin.Context) {
 		}
 	}
 
+	if jobApplication != nil {
+		setETag(ctx, jobApplication.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": jobApplication})
 }
 
@@ -136,7 +180,7 @@ func (jh *JobApplicationHandler) CreateJobApplication(ctx *gin.Context) {
 		return
 	}
 
-	err := jh.jobApplicationService.CreateJobApplication(&dto)
+	err := jh.jobApplicationService.CreateJobApplication(ctx.Request.Context(), &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -157,12 +201,13 @@ func (jh *JobApplicationHandler) CreateJobApplication(ctx *gin.Context) {
 // UpdateJobApplication godoc
 // @Tags JobApplication
 // @Summary JobApplication 수정
-// @Description JobApplication 수정
+// @Description JobApplication 수정, 부서 채용 파이프라인이 있으면 허용된 단계로만 이동 가능하며 이동 기록이 남음
 // @ID UpdateJobApplication
 // @Accept  json
 // @Produce  json
 // @Param jobApplicationId path string true "JobApplication ID"
 // @Param jobApplication body dto.JobApplicationUpdateDTO true "JobApplication 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /jobApplications/{jobApplicationId} [patch]
 // @Success 200 {object} dto.APIResponse[JobApplication]
 // @Failure 500
@@ -182,7 +227,9 @@ func (jh *JobApplicationHandler) UpdateJobApplication(ctx *gin.Context) {
 		return
 	}
 
-	jobApplication, err := jh.jobApplicationService.UpdateJobApplication(jobApplicationId, &dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	jobApplication, err := jh.jobApplicationService.UpdateJobApplication(ctx.Request.Context(), jobApplicationId, &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -197,24 +244,59 @@ func (jh *JobApplicationHandler) UpdateJobApplication(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, jobApplication.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": jobApplication})
 }
 
+// GetJobApplicationStageDurations godoc
+// @Tags JobApplication
+// @Summary JobApplication 단계별 체류 시간 조회
+// @Description 단계 이동 기록으로 계산한 단계별 체류 시간 조회, 현재 단계는 지금까지의 시간
+// @ID GetJobApplicationStageDurations
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Router /jobApplications/{jobApplicationId}/stage-durations [get]
+// @Success 200 {object} dto.APIResponse[[]StageDuration]
+// @Failure 500
+func (jh *JobApplicationHandler) GetJobApplicationStageDurations(ctx *gin.Context) {
+	jobApplicationId := ctx.Param("id")
+
+	durations, err := jh.jobApplicationService.GetJobApplicationStageDurations(jobApplicationId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": durations})
+}
+
 // DeleteJobApplication godoc
 // @Tags JobApplication
 // @Summary JobApplication 삭제
-// @Description JobApplication 삭제
+// @Description JobApplication을 휴지통으로 이동
 // @ID DeleteJobApplication
 // @Accept  json
 // @Produce  json
 // @Param jobApplicationId path string true "JobApplication ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /jobApplications/{jobApplicationId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (jh *JobApplicationHandler) DeleteJobApplication(ctx *gin.Context) {
 	jobApplicationId := ctx.Param("id")
 
-	err := jh.jobApplicationService.DeleteJobApplication(jobApplicationId)
+	err := jh.jobApplicationService.DeleteJobApplication(ctx.Request.Context(), jobApplicationId)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -231,3 +313,138 @@ func (jh *JobApplicationHandler) DeleteJobApplication(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
 }
+
+// GetJobApplicationInterviews godoc
+// @Tags JobApplication
+// @Summary JobApplication 면접 목록 조회
+// @Description JobApplication에 연결된 면접 Meeting과 결과를 시작 일시 순으로 조회
+// @ID GetJobApplicationInterviews
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Router /jobApplications/{jobApplicationId}/interviews [get]
+// @Success 200 {object} dto.APIResponse[[]Meeting]
+// @Failure 500
+func (jh *JobApplicationHandler) GetJobApplicationInterviews(ctx *gin.Context) {
+	jobApplicationId := ctx.Param("id")
+
+	interviews, err := jh.jobApplicationService.GetJobApplicationInterviews(jobApplicationId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": interviews})
+}
+
+// ScheduleInterview godoc
+// @Tags JobApplication
+// @Summary 면접 일정 생성
+// @Description 면접관을 참석자로, 지원자 정보를 설명으로 하는 면접 Meeting 생성
+// @ID ScheduleInterview
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Param interview body dto.InterviewCreateDTO true "면접 정보"
+// @Router /jobApplications/{jobApplicationId}/interviews [post]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (jh *JobApplicationHandler) ScheduleInterview(ctx *gin.Context) {
+	var dto dto.InterviewCreateDTO
+	jobApplicationId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	interview, err := jh.jobApplicationService.ScheduleInterview(ctx.Request.Context(), jobApplicationId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, interview.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": interview})
+}
+
+// UpdateInterviewOutcome godoc
+// @Tags JobApplication
+// @Summary 면접 결과 기록
+// @Description 면접 결과(pending/passed/failed/no_show) 기록
+// @ID UpdateInterviewOutcome
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Param meetingId path string true "면접 Meeting ID"
+// @Param outcome body dto.InterviewOutcomeDTO true "면접 결과"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /jobApplications/{jobApplicationId}/interviews/{meetingId}/outcome [patch]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (jh *JobApplicationHandler) UpdateInterviewOutcome(ctx *gin.Context) {
+	var dto dto.InterviewOutcomeDTO
+	jobApplicationId := ctx.Param("id")
+	meetingId := ctx.Param("meetingId")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	interview, err := jh.jobApplicationService.UpdateInterviewOutcome(ctx.Request.Context(), jobApplicationId, meetingId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, interview.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": interview})
+}
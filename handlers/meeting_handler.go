@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -75,24 +76,79 @@ func (mh *MeetingHandler) GetMeeting(ctx *gin.Context) {
 		}
 	}
 
+	if meeting != nil {
+		setETag(ctx, meeting.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
 }
 
 // GetMeetingByUser godoc
 // @Tags Meeting
 // @Summary Meeting 조회(유저)
-// @Description Meeting 조회(유저)
+// @Description 유저가 작성했거나 참석자로 초대된 Meeting 조회
 // @ID GetMeetingByUser
 // @Accept  json
 // @Produce  json
-// @Param userId path string true "Created By ID"
+// @Param userId path string true "User ID"
+// @Param role query string false "creator: 작성한 Meeting, participant: 초대된 Meeting, 생략 시 모두"
+// @Param when query string false "upcoming: 예정된 Meeting, past: 지난 Meeting"
+// @Param from query string false "조회 시작 일시(RFC3339), to와 함께 주면 반복 Meeting을 회차별로 반환"
+// @Param to query string false "조회 종료 일시(RFC3339)"
 // @Router /meetings/created-by/{userId} [get]
 // @Success 200 {object} dto.APIResponse[[]Meeting]
 // @Failure 500
 func (mh *MeetingHandler) GetMeetingByUser(ctx *gin.Context) {
+	var query dto.MeetingQueryDTO
 	userId := ctx.Param("id")
 
-	meetings, err := mh.meetingService.GetMeetingByUser(userId)
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	meetings, err := mh.meetingService.GetMeetingByUser(userId, &query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meetings})
+}
+
+// GetMeetingCalendar godoc
+// @Tags Meeting
+// @Summary Meeting 캘린더 조회
+// @Description 기간 내 Meeting 조회 (반복 Meeting은 회차별로 펼쳐서 반환)
+// @ID GetMeetingCalendar
+// @Accept  json
+// @Produce  json
+// @Param from query string true "조회 시작 일시(RFC3339)"
+// @Param to query string true "조회 종료 일시(RFC3339)"
+// @Param user query string false "User ID"
+// @Param role query string false "creator: 작성한 Meeting, participant: 초대된 Meeting, 생략 시 모두"
+// @Param when query string false "upcoming: 예정된 Meeting, past: 지난 Meeting"
+// @Router /meetings/calendar [get]
+// @Success 200 {object} dto.APIResponse[[]Meeting]
+// @Failure 500
+func (mh *MeetingHandler) GetMeetingCalendar(ctx *gin.Context) {
+	var query dto.MeetingQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	meetings, err := mh.meetingService.GetMeetingCalendar(&query)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -136,7 +192,7 @@ func (mh *MeetingHandler) CreateMeeting(ctx *gin.Context) {
 		return
 	}
 
-	err := mh.meetingService.CreateMeeting(&dto)
+	err := mh.meetingService.CreateMeeting(ctx.Request.Context(), &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -157,12 +213,13 @@ func (mh *MeetingHandler) CreateMeeting(ctx *gin.Context) {
 // UpdateMeeting godoc
 // @Tags Meeting
 // @Summary Meeting 수정
-// @Description Meeting 수정
+// @Description Meeting 수정, 시작 일시나 반복 규칙을 바꾸면 회차별 예외를 새 회차로 옮기고 없어진 회차의 예외는 버림
 // @ID UpdateMeeting
 // @Accept  json
 // @Produce  json
 // @Param meetingId path string true "Meeting ID"
 // @Param meeting body dto.MeetingUpdateDTO true "Meeting 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /meetings/{meetingId} [patch]
 // @Success 200 {object} dto.APIResponse[Meeting]
 // @Failure 500
@@ -182,7 +239,7 @@ func (mh *MeetingHandler) UpdateMeeting(ctx *gin.Context) {
 		return
 	}
 
-	meeting, err := mh.meetingService.UpdateMeeting(meetingId, &dto)
+	meeting, err := mh.meetingService.UpdateMeeting(ctx.Request.Context(), meetingId, &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -197,24 +254,27 @@ func (mh *MeetingHandler) UpdateMeeting(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, meeting.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
 }
 
 // DeleteMeeting godoc
 // @Tags Meeting
 // @Summary Meeting 삭제
-// @Description Meeting 삭제
+// @Description Meeting을 휴지통으로 이동
 // @ID DeleteMeeting
 // @Accept  json
 // @Produce  json
 // @Param meetingId path string true "Meeting ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /meetings/{meetingId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (mh *MeetingHandler) DeleteMeeting(ctx *gin.Context) {
 	meetingId := ctx.Param("id")
 
-	err := mh.meetingService.DeleteMeeting(meetingId)
+	err := mh.meetingService.DeleteMeeting(ctx.Request.Context(), meetingId)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -231,3 +291,235 @@ func (mh *MeetingHandler) DeleteMeeting(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
 }
+
+// RespondMeeting godoc
+// @Tags Meeting
+// @Summary Meeting 참석 응답
+// @Description 로그인한 참석자 본인의 Meeting 참석 응답(accepted/declined/tentative)
+// @ID RespondMeeting
+// @Accept  json
+// @Produce  json
+// @Param meetingId path string true "Meeting ID"
+// @Param rsvp body dto.MeetingRSVPDTO true "참석 응답 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /meetings/{meetingId}/rsvp [patch]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (mh *MeetingHandler) RespondMeeting(ctx *gin.Context) {
+	var dto dto.MeetingRSVPDTO
+	meetingId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	meeting, err := mh.meetingService.RespondMeeting(ctx.Request.Context(), meetingId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, meeting.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
+}
+
+// MarkAttendance godoc
+// @Tags Meeting
+// @Summary Meeting 출석 기록
+// @Description Meeting 작성자가 Meeting 시작 이후 참석자별 출석 여부 기록
+// @ID MarkAttendance
+// @Accept  json
+// @Produce  json
+// @Param meetingId path string true "Meeting ID"
+// @Param attendance body dto.MeetingAttendanceDTO true "출석 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /meetings/{meetingId}/attendance [patch]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (mh *MeetingHandler) MarkAttendance(ctx *gin.Context) {
+	var dto dto.MeetingAttendanceDTO
+	meetingId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	meeting, err := mh.meetingService.MarkAttendance(ctx.Request.Context(), meetingId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, meeting.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
+}
+
+// UpdateMeetingOccurrence godoc
+// @Tags Meeting
+// @Summary 반복 Meeting 회차 취소/변경
+// @Description 반복 Meeting의 특정 회차만 취소하거나 변경 (시리즈는 유지)
+// @ID UpdateMeetingOccurrence
+// @Accept  json
+// @Produce  json
+// @Param meetingId path string true "Meeting ID"
+// @Param occurrence body dto.MeetingOccurrenceDTO true "회차 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /meetings/{meetingId}/occurrences [patch]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (mh *MeetingHandler) UpdateMeetingOccurrence(ctx *gin.Context) {
+	var dto dto.MeetingOccurrenceDTO
+	meetingId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	meeting, err := mh.meetingService.UpdateMeetingOccurrence(ctx.Request.Context(), meetingId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, meeting.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
+}
+
+// UpdateMeetingMinutes godoc
+// @Tags Meeting
+// @Summary Meeting 회의록 저장
+// @Description 안건, 결정 사항, 액션 아이템으로 구성된 회의록 저장
+// @ID UpdateMeetingMinutes
+// @Accept  json
+// @Produce  json
+// @Param meetingId path string true "Meeting ID"
+// @Param minutes body dto.MeetingMinutesDTO true "회의록 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /meetings/{meetingId}/minutes [put]
+// @Success 200 {object} dto.APIResponse[Meeting]
+// @Failure 500
+func (mh *MeetingHandler) UpdateMeetingMinutes(ctx *gin.Context) {
+	var dto dto.MeetingMinutesDTO
+	meetingId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	meeting, err := mh.meetingService.UpdateMeetingMinutes(ctx.Request.Context(), meetingId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, meeting.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": meeting})
+}
+
+// ConvertActionItemsToTodos godoc
+// @Tags Meeting
+// @Summary 액션 아이템 Todo 변환
+// @Description 회의록의 액션 아이템을 담당자의 Todo로 변환 (이미 변환된 아이템은 제외)
+// @ID ConvertActionItemsToTodos
+// @Accept  json
+// @Produce  json
+// @Param meetingId path string true "Meeting ID"
+// @Router /meetings/{meetingId}/minutes/todos [post]
+// @Success 200 {object} dto.APIResponse[[]Todo]
+// @Failure 500
+func (mh *MeetingHandler) ConvertActionItemsToTodos(ctx *gin.Context) {
+	meetingId := ctx.Param("id")
+
+	todos, err := mh.meetingService.ConvertActionItemsToTodos(ctx.Request.Context(), meetingId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": todos})
+}
@@ -75,6 +75,10 @@ func (nh *NoteHandler) GetNote(ctx *gin.Context) {
 		}
 	}
 
+	if note != nil {
+		setETag(ctx, note.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": note})
 }
 
@@ -136,7 +140,7 @@ func (nh *NoteHandler) CreateNote(ctx *gin.Context) {
 		return
 	}
 
-	err := nh.noteService.CreateNote(&dto)
+	err := nh.noteService.CreateNote(ctx.Request.Context(), &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -163,6 +167,7 @@ func (nh *NoteHandler) CreateNote(ctx *gin.Context) {
 // @Produce  json
 // @Param noteId path string true "Note ID"
 // @Param note body dto.NoteUpdateDTO true "노트 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /notes/{noteId} [patch]
 // @Success 200 {object} dto.APIResponse[Note]
 // @Failure 500
@@ -182,7 +187,7 @@ func (nh *NoteHandler) UpdateNote(ctx *gin.Context) {
 		return
 	}
 
-	note, err := nh.noteService.UpdateNote(noteId, &dto)
+	note, err := nh.noteService.UpdateNote(ctx.Request.Context(), noteId, &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -197,24 +202,27 @@ func (nh *NoteHandler) UpdateNote(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, note.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": note})
 }
 
 // DeleteNote godoc
 // @Tags Note
 // @Summary 노트 삭제
-// @Description 노트 삭제
+// @Description 노트를 휴지통으로 이동
 // @ID DeleteNote
 // @Accept  json
 // @Produce  json
 // @Param noteId path string true "Note ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /notes/{noteId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (nh *NoteHandler) DeleteNote(ctx *gin.Context) {
 	noteId := ctx.Param("id")
 
-	err := nh.noteService.DeleteNote(noteId)
+	err := nh.noteService.DeleteNote(ctx.Request.Context(), noteId)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
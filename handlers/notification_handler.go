@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService services.NotificationService
+}
+
+func NewNotificationHandler(notificationService services.NotificationService) NotificationHandler {
+	return NotificationHandler{notificationService}
+}
+
+// GetMyNotification godoc
+// @Tags Notification
+// @Summary 내 알림 조회
+// @Description 로그인한 유저의 알림 조회 (최신순)
+// @ID GetMyNotification
+// @Accept  json
+// @Produce  json
+// @Param unread query bool false "읽지 않은 알림만 조회"
+// @Router /notifications [get]
+// @Success 200 {object} dto.APIResponse[[]Notification]
+// @Failure 500
+func (nh *NotificationHandler) GetMyNotification(ctx *gin.Context) {
+	var query dto.NotificationQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	notifications, err := nh.notificationService.GetNotificationByUser(&query, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": notifications})
+}
+
+// ReadNotification godoc
+// @Tags Notification
+// @Summary 알림 읽음 처리
+// @Description 알림 읽음 처리
+// @ID ReadNotification
+// @Accept  json
+// @Produce  json
+// @Param notificationId path string true "Notification ID"
+// @Router /notifications/{notificationId}/read [patch]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (nh *NotificationHandler) ReadNotification(ctx *gin.Context) {
+	notificationId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := nh.notificationService.ReadNotification(notificationId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
+
+// ReadAllNotification godoc
+// @Tags Notification
+// @Summary 전체 알림 읽음 처리
+// @Description 로그인한 유저의 읽지 않은 알림을 모두 읽음 처리
+// @ID ReadAllNotification
+// @Accept  json
+// @Produce  json
+// @Router /notifications/read [patch]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (nh *NotificationHandler) ReadAllNotification(ctx *gin.Context) {
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := nh.notificationService.ReadAllNotification(currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
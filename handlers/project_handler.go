@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -20,15 +21,25 @@ func NewProjectHandler(projectService services.ProjectService) ProjectHandler {
 // GetAllProject godoc
 // @Tags Project
 // @Summary 전체 Project 조회
-// @Description 전체 Project 조회
+// @Description 로그인한 유저가 멤버인 Project 조회, 보관된 Project는 기본적으로 제외
 // @ID GetAllProject
 // @Accept  json
 // @Produce  json
+// @Param include_archived query bool false "보관된 Project 포함 여부"
 // @Router /projects [get]
 // @Success 200 {object} dto.APIResponse[[]Project]
 // @Failure 500
 func (ph *ProjectHandler) GetAllProject(ctx *gin.Context) {
-	projects, err := ph.projectService.GetAllProject()
+	var query dto.ProjectQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	projects, err := ph.projectService.GetAllProject(&query, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -46,6 +57,44 @@ func (ph *ProjectHandler) GetAllProject(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": projects})
 }
 
+// GetProject godoc
+// @Tags Project
+// @Summary Project 상세 조회
+// @Description Project 상세 조회 (Task/Todo 상태별 개수, 진행률, 지연 건수, 담당자별 업무량, 남은 일수)
+// @ID GetProject
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Router /projects/{projectId} [get]
+// @Success 200 {object} dto.APIResponse[ProjectDetail]
+// @Failure 500
+func (ph *ProjectHandler) GetProject(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.GetProject(projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	if project != nil {
+		setETag(ctx, project.Version)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
 // CreateProject godoc
 // @Tags Project
 // @Summary Project 생성
@@ -72,7 +121,9 @@ func (ph *ProjectHandler) CreateProject(ctx *gin.Context) {
 		return
 	}
 
-	err := ph.projectService.CreateProject(&dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := ph.projectService.CreateProject(ctx.Request.Context(), &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -93,12 +144,13 @@ func (ph *ProjectHandler) CreateProject(ctx *gin.Context) {
 // UpdateProject godoc
 // @Tags Project
 // @Summary Project 수정
-// @Description Project 수정
+// @Description Project 수정, 기간을 바꾸면 기존 마일스톤과 Task 일정이 모두 새 기간 안에 있어야 하며 벗어나면 409
 // @ID UpdateProject
 // @Accept  json
 // @Produce  json
 // @Param projectId path string true "Project ID"
 // @Param project body dto.ProjectUpdateDTO true "Project 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /projects/{projectId} [patch]
 // @Success 200 {object} dto.APIResponse[Project]
 // @Failure 500
@@ -118,7 +170,9 @@ func (ph *ProjectHandler) UpdateProject(ctx *gin.Context) {
 		return
 	}
 
-	project, err := ph.projectService.UpdateProject(projectId, &dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.UpdateProject(ctx.Request.Context(), projectId, &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -133,24 +187,36 @@ func (ph *ProjectHandler) UpdateProject(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, project.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
 }
 
 // DeleteProject godoc
 // @Tags Project
 // @Summary Project 삭제
-// @Description Project 삭제
+// @Description Project를 휴지통으로 이동, Project 소유자만 가능
 // @ID DeleteProject
 // @Accept  json
 // @Produce  json
 // @Param projectId path string true "Project ID"
+// @Param mode query string false "restrict(기본값): 하위 Task/Todo가 있으면 409, cascade: 하위 Task/Todo를 트랜잭션으로 함께 휴지통으로 이동"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /projects/{projectId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (ph *ProjectHandler) DeleteProject(ctx *gin.Context) {
+	var query dto.ProjectDeleteDTO
 	projectId := ctx.Param("id")
 
-	err := ph.projectService.DeleteProject(projectId)
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := ph.projectService.DeleteProject(ctx.Request.Context(), projectId, query.Mode, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -167,3 +233,376 @@ func (ph *ProjectHandler) DeleteProject(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
 }
+
+// ArchiveProject godoc
+// @Tags Project
+// @Summary Project 보관
+// @Description Project 보관, 보관된 Project와 하위 Task/Todo는 읽기 전용(수정 시 409), Project 소유자만 가능
+// @ID ArchiveProject
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /projects/{projectId}/archive [patch]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) ArchiveProject(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.ArchiveProject(ctx.Request.Context(), projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// UnarchiveProject godoc
+// @Tags Project
+// @Summary Project 보관 해제
+// @Description Project 보관 해제, Project 소유자만 가능
+// @ID UnarchiveProject
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /projects/{projectId}/unarchive [patch]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) UnarchiveProject(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.UnarchiveProject(ctx.Request.Context(), projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// AddProjectMember godoc
+// @Tags Project
+// @Summary Project 멤버 추가
+// @Description Project 멤버 추가 또는 역할 변경 (maintainer, viewer), Project 소유자만 가능
+// @ID AddProjectMember
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param member body dto.ProjectMemberDTO true "멤버 정보"
+// @Router /projects/{projectId}/members [post]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) AddProjectMember(ctx *gin.Context) {
+	var dto dto.ProjectMemberDTO
+	projectId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.AddProjectMember(ctx.Request.Context(), projectId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// RemoveProjectMember godoc
+// @Tags Project
+// @Summary Project 멤버 제거
+// @Description Project 멤버 제거, Project 소유자만 가능
+// @ID RemoveProjectMember
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param userId path string true "User ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /projects/{projectId}/members/{userId} [delete]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) RemoveProjectMember(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+	memberId := ctx.Param("userId")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.RemoveProjectMember(ctx.Request.Context(), projectId, memberId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// AddProjectMilestone godoc
+// @Tags Project
+// @Summary Project 마일스톤 추가
+// @Description Project 마일스톤 추가, 마감일은 Project 기간 안이어야 하고 같은 Project의 Task만 연결 가능
+// @ID AddProjectMilestone
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param milestone body dto.ProjectMilestoneDTO true "마일스톤 정보"
+// @Router /projects/{projectId}/milestones [post]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) AddProjectMilestone(ctx *gin.Context) {
+	var dto dto.ProjectMilestoneDTO
+	projectId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.AddProjectMilestone(ctx.Request.Context(), projectId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// UpdateProjectMilestone godoc
+// @Tags Project
+// @Summary Project 마일스톤 수정
+// @Description Project 마일스톤 수정, tasks를 전달하면 연결된 Task 목록을 교체
+// @ID UpdateProjectMilestone
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param milestoneId path string true "Milestone ID"
+// @Param milestone body dto.ProjectMilestoneDTO true "마일스톤 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /projects/{projectId}/milestones/{milestoneId} [patch]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) UpdateProjectMilestone(ctx *gin.Context) {
+	var dto dto.ProjectMilestoneDTO
+	projectId := ctx.Param("id")
+	milestoneId := ctx.Param("milestoneId")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.UpdateProjectMilestone(ctx.Request.Context(), projectId, milestoneId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// DeleteProjectMilestone godoc
+// @Tags Project
+// @Summary Project 마일스톤 삭제
+// @Description Project 마일스톤 삭제, 연결된 Task는 삭제되지 않음
+// @ID DeleteProjectMilestone
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Param milestoneId path string true "Milestone ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /projects/{projectId}/milestones/{milestoneId} [delete]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (ph *ProjectHandler) DeleteProjectMilestone(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+	milestoneId := ctx.Param("milestoneId")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := ph.projectService.DeleteProjectMilestone(ctx.Request.Context(), projectId, milestoneId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, project.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// GetProjectTimeline godoc
+// @Tags Project
+// @Summary Project 타임라인 조회
+// @Description Gantt 차트용 Project, 마일스톤, Task 일정 조회
+// @ID GetProjectTimeline
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Router /projects/{projectId}/timeline [get]
+// @Success 200 {object} dto.APIResponse[ProjectTimeline]
+// @Failure 500
+func (ph *ProjectHandler) GetProjectTimeline(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	timeline, err := ph.projectService.GetProjectTimeline(projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": timeline})
+}
+
+// GetProjectCriticalPath godoc
+// @Tags Project
+// @Summary Project 임계 경로 조회
+// @Description 선행 Task 관계와 Task 일정(start_dt ~ end_dt)으로 계산한 Project 임계 경로 조회
+// @ID GetProjectCriticalPath
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Router /projects/{projectId}/critical-path [get]
+// @Success 200 {object} dto.APIResponse[ProjectCriticalPath]
+// @Failure 500
+func (ph *ProjectHandler) GetProjectCriticalPath(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	criticalPath, err := ph.projectService.GetProjectCriticalPath(projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": criticalPath})
+}
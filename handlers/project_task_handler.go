@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -31,7 +32,9 @@ func NewProjectTaskHandler(projectTaskService services.ProjectTaskService) Proje
 func (pth *ProjectTaskHandler) GetProjectTask(ctx *gin.Context) {
 	taskId := ctx.Param("id")
 
-	task, err := pth.projectTaskService.GetProjectTask(taskId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	task, err := pth.projectTaskService.GetProjectTask(taskId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -46,6 +49,10 @@ func (pth *ProjectTaskHandler) GetProjectTask(ctx *gin.Context) {
 		}
 	}
 
+	if task != nil {
+		setETag(ctx, task.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": task})
 }
 
@@ -63,7 +70,9 @@ func (pth *ProjectTaskHandler) GetProjectTask(ctx *gin.Context) {
 func (pth *ProjectTaskHandler) GetProjectTaskByProject(ctx *gin.Context) {
 	projectId := ctx.Param("id")
 
-	tasks, err := pth.projectTaskService.GetProjectTaskByProject(projectId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	tasks, err := pth.projectTaskService.GetProjectTaskByProject(projectId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -84,7 +93,7 @@ func (pth *ProjectTaskHandler) GetProjectTaskByProject(ctx *gin.Context) {
 // CreateProjectTask godoc
 // @Tags ProjectTask
 // @Summary ProjectTask 생성
-// @Description ProjectTask 생성
+// @Description ProjectTask 생성, start_dt/end_dt는 Project 기간 안이어야 하고 blocked_by는 같은 Project의 Task만 지정 가능
 // @ID CreateProjectTask
 // @Accept  json
 // @Produce  json
@@ -107,7 +116,9 @@ func (pth *ProjectTaskHandler) CreateProjectTask(ctx *gin.Context) {
 		return
 	}
 
-	err := pth.projectTaskService.CreateProjectTask(&dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := pth.projectTaskService.CreateProjectTask(ctx.Request.Context(), &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -128,12 +139,13 @@ func (pth *ProjectTaskHandler) CreateProjectTask(ctx *gin.Context) {
 // UpdateProjectTask godoc
 // @Tags ProjectTask
 // @Summary ProjectTask 수정
-// @Description ProjectTask 수정
+// @Description ProjectTask 수정, 선행 Task가 순환하면 409, 선행 Task가 완료되지 않았으면 done으로 변경 불가(409)
 // @ID UpdateProjectTask
 // @Accept  json
 // @Produce  json
 // @Param taskId path string true "Project Task ID"
 // @Param projectTask body dto.ProjectTaskUpdateDTO true "ProjectTask 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /project-tasks/{taskId} [patch]
 // @Success 200 {object} dto.APIResponse[ProjectTask]
 // @Failure 500
@@ -153,7 +165,9 @@ func (pth *ProjectTaskHandler) UpdateProjectTask(ctx *gin.Context) {
 		return
 	}
 
-	task, err := pth.projectTaskService.UpdateProjectTask(taskId, &dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	task, err := pth.projectTaskService.UpdateProjectTask(ctx.Request.Context(), taskId, &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -168,24 +182,29 @@ func (pth *ProjectTaskHandler) UpdateProjectTask(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, task.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": task})
 }
 
 // DeleteProjectTask godoc
 // @Tags ProjectTask
 // @Summary ProjectTask 삭제
-// @Description ProjectTask 삭제
+// @Description ProjectTask를 휴지통으로 이동, 연결된 마일스톤과 다른 Task의 선행 Task에서는 제거
 // @ID DeleteProjectTask
 // @Accept  json
 // @Produce  json
 // @Param taskId path string true "ProjectTask ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /project-tasks/{taskId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (pth *ProjectTaskHandler) DeleteProjectTask(ctx *gin.Context) {
 	taskId := ctx.Param("id")
 
-	err := pth.projectTaskService.DeleteProjectTask(taskId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := pth.projectTaskService.DeleteProjectTask(ctx.Request.Context(), taskId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectTemplateHandler struct {
+	projectTemplateService services.ProjectTemplateService
+}
+
+func NewProjectTemplateHandler(projectTemplateService services.ProjectTemplateService) ProjectTemplateHandler {
+	return ProjectTemplateHandler{projectTemplateService}
+}
+
+// GetAllProjectTemplate godoc
+// @Tags ProjectTemplate
+// @Summary 전체 Project 템플릿 조회
+// @Description 전체 Project 템플릿 조회
+// @ID GetAllProjectTemplate
+// @Accept  json
+// @Produce  json
+// @Router /project-templates [get]
+// @Success 200 {object} dto.APIResponse[[]ProjectTemplate]
+// @Failure 500
+func (pth *ProjectTemplateHandler) GetAllProjectTemplate(ctx *gin.Context) {
+	templates, err := pth.projectTemplateService.GetAllProjectTemplate()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": templates})
+}
+
+// GetProjectTemplate godoc
+// @Tags ProjectTemplate
+// @Summary Project 템플릿 조회
+// @Description Project 템플릿 조회
+// @ID GetProjectTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ProjectTemplate ID"
+// @Router /project-templates/{templateId} [get]
+// @Success 200 {object} dto.APIResponse[ProjectTemplate]
+// @Failure 500
+func (pth *ProjectTemplateHandler) GetProjectTemplate(ctx *gin.Context) {
+	templateId := ctx.Param("id")
+
+	template, err := pth.projectTemplateService.GetProjectTemplate(templateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": template})
+}
+
+// CreateProjectTemplate godoc
+// @Tags ProjectTemplate
+// @Summary Project 템플릿 생성
+// @Description Project와 Task, 마일스톤을 템플릿으로 저장, 일정은 Project 시작일 기준 분 단위 오프셋으로 저장
+// @ID CreateProjectTemplate
+// @Accept  json
+// @Produce  json
+// @Param template body dto.ProjectTemplateCreateDTO true "템플릿 정보"
+// @Router /project-templates [post]
+// @Success 200 {object} dto.APIResponse[ProjectTemplate]
+// @Failure 500
+func (pth *ProjectTemplateHandler) CreateProjectTemplate(ctx *gin.Context) {
+	var dto dto.ProjectTemplateCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	template, err := pth.projectTemplateService.CreateProjectTemplate(&dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": template})
+}
+
+// InstantiateProjectTemplate godoc
+// @Tags ProjectTemplate
+// @Summary Project 템플릿으로 Project 생성
+// @Description 템플릿 일정을 start_dt 기준으로 옮겨 Project와 Task, 마일스톤을 한 트랜잭션으로 생성, managers로 담당자를 바꿀 수 있음
+// @ID InstantiateProjectTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ProjectTemplate ID"
+// @Param instantiate body dto.ProjectTemplateInstantiateDTO true "생성 정보"
+// @Router /project-templates/{templateId}/instantiate [post]
+// @Success 200 {object} dto.APIResponse[Project]
+// @Failure 500
+func (pth *ProjectTemplateHandler) InstantiateProjectTemplate(ctx *gin.Context) {
+	var dto dto.ProjectTemplateInstantiateDTO
+	templateId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	project, err := pth.projectTemplateService.InstantiateProjectTemplate(ctx.Request.Context(), templateId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": project})
+}
+
+// DeleteProjectTemplate godoc
+// @Tags ProjectTemplate
+// @Summary Project 템플릿 삭제
+// @Description Project 템플릿 삭제, 템플릿 작성자만 가능
+// @ID DeleteProjectTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ProjectTemplate ID"
+// @Router /project-templates/{templateId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (pth *ProjectTemplateHandler) DeleteProjectTemplate(ctx *gin.Context) {
+	templateId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := pth.projectTemplateService.DeleteProjectTemplate(templateId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type RecruitmentPipelineHandler struct {
+	recruitmentPipelineService services.RecruitmentPipelineService
+}
+
+func NewRecruitmentPipelineHandler(recruitmentPipelineService services.RecruitmentPipelineService) RecruitmentPipelineHandler {
+	return RecruitmentPipelineHandler{recruitmentPipelineService}
+}
+
+// GetAllRecruitmentPipeline godoc
+// @Tags RecruitmentPipeline
+// @Summary 전체 채용 파이프라인 조회
+// @Description 전체 채용 파이프라인 조회
+// @ID GetAllRecruitmentPipeline
+// @Accept  json
+// @Produce  json
+// @Router /recruitment-pipelines [get]
+// @Success 200 {object} dto.APIResponse[[]RecruitmentPipeline]
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) GetAllRecruitmentPipeline(ctx *gin.Context) {
+	pipelines, err := rph.recruitmentPipelineService.GetAllRecruitmentPipeline()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": pipelines})
+}
+
+// GetRecruitmentPipeline godoc
+// @Tags RecruitmentPipeline
+// @Summary 채용 파이프라인 조회
+// @Description 채용 파이프라인 조회
+// @ID GetRecruitmentPipeline
+// @Accept  json
+// @Produce  json
+// @Param pipelineId path string true "RecruitmentPipeline ID"
+// @Router /recruitment-pipelines/{pipelineId} [get]
+// @Success 200 {object} dto.APIResponse[RecruitmentPipeline]
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) GetRecruitmentPipeline(ctx *gin.Context) {
+	pipelineId := ctx.Param("id")
+
+	pipeline, err := rph.recruitmentPipelineService.GetRecruitmentPipeline(pipelineId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	if pipeline != nil {
+		setETag(ctx, pipeline.Version)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": pipeline})
+}
+
+// GetRecruitmentPipelineMetrics godoc
+// @Tags RecruitmentPipeline
+// @Summary 채용 단계별 지표 조회
+// @Description 부서 지원서의 단계별 현재 인원, 진입 횟수, 평균 체류 시간(다음 단계로 넘어간 기록 기준) 조회
+// @ID GetRecruitmentPipelineMetrics
+// @Accept  json
+// @Produce  json
+// @Param pipelineId path string true "RecruitmentPipeline ID"
+// @Router /recruitment-pipelines/{pipelineId}/metrics [get]
+// @Success 200 {object} dto.APIResponse[[]StageMetric]
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) GetRecruitmentPipelineMetrics(ctx *gin.Context) {
+	pipelineId := ctx.Param("id")
+
+	metrics, err := rph.recruitmentPipelineService.GetRecruitmentPipelineMetrics(pipelineId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": metrics})
+}
+
+// CreateRecruitmentPipeline godoc
+// @Tags RecruitmentPipeline
+// @Summary 채용 파이프라인 생성
+// @Description 관리자가 부서별 채용 단계 정의 생성, 부서당 하나만 생성 가능
+// @ID CreateRecruitmentPipeline
+// @Accept  json
+// @Produce  json
+// @Param pipeline body dto.RecruitmentPipelineCreateDTO true "채용 파이프라인 정보"
+// @Router /recruitment-pipelines [post]
+// @Success 200 {object} dto.APIResponse[RecruitmentPipeline]
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) CreateRecruitmentPipeline(ctx *gin.Context) {
+	var dto dto.RecruitmentPipelineCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	pipeline, err := rph.recruitmentPipelineService.CreateRecruitmentPipeline(&dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": pipeline})
+}
+
+// UpdateRecruitmentPipeline godoc
+// @Tags RecruitmentPipeline
+// @Summary 채용 파이프라인 수정
+// @Description 관리자가 채용 파이프라인 수정, stages를 전달하면 단계 전체를 교체하며 지원서가 남아 있는 단계를 빼면 409
+// @ID UpdateRecruitmentPipeline
+// @Accept  json
+// @Produce  json
+// @Param pipelineId path string true "RecruitmentPipeline ID"
+// @Param pipeline body dto.RecruitmentPipelineUpdateDTO true "채용 파이프라인 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /recruitment-pipelines/{pipelineId} [patch]
+// @Success 200 {object} dto.APIResponse[RecruitmentPipeline]
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) UpdateRecruitmentPipeline(ctx *gin.Context) {
+	var dto dto.RecruitmentPipelineUpdateDTO
+	pipelineId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	pipeline, err := rph.recruitmentPipelineService.UpdateRecruitmentPipeline(ctx.Request.Context(), pipelineId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, pipeline.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": pipeline})
+}
+
+// DeleteRecruitmentPipeline godoc
+// @Tags RecruitmentPipeline
+// @Summary 채용 파이프라인 삭제
+// @Description 관리자가 채용 파이프라인 삭제
+// @ID DeleteRecruitmentPipeline
+// @Accept  json
+// @Produce  json
+// @Param pipelineId path string true "RecruitmentPipeline ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /recruitment-pipelines/{pipelineId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (rph *RecruitmentPipelineHandler) DeleteRecruitmentPipeline(ctx *gin.Context) {
+	pipelineId := ctx.Param("id")
+
+	err := rph.recruitmentPipelineService.DeleteRecruitmentPipeline(ctx.Request.Context(), pipelineId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
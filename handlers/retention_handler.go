@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type RetentionHandler struct {
+	retentionService services.RetentionService
+}
+
+func NewRetentionHandler(retentionService services.RetentionService) RetentionHandler {
+	return RetentionHandler{retentionService}
+}
+
+// GetAllRetentionPolicy godoc
+// @Tags Retention
+// @Summary 전체 보존 정책 조회
+// @Description 지원 결과별 개인정보 보존 정책 조회
+// @ID GetAllRetentionPolicy
+// @Accept  json
+// @Produce  json
+// @Router /retention-policies [get]
+// @Success 200 {object} dto.APIResponse[[]RetentionPolicy]
+// @Failure 500
+func (rh *RetentionHandler) GetAllRetentionPolicy(ctx *gin.Context) {
+	policies, err := rh.retentionService.GetAllRetentionPolicy()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": policies})
+}
+
+// SetRetentionPolicy godoc
+// @Tags Retention
+// @Summary 보존 정책 설정
+// @Description 관리자가 지원 결과별 보존 기간 설정, 채용 결정 일시(결정이 없으면 마지막 수정 일시)부터 기간이 지나면 익명화
+// @ID SetRetentionPolicy
+// @Accept  json
+// @Produce  json
+// @Param outcome path string true "지원 결과 (hire/reject/hold/undecided)"
+// @Param policy body dto.RetentionPolicyDTO true "보존 기간"
+// @Router /retention-policies/{outcome} [put]
+// @Success 200 {object} dto.APIResponse[RetentionPolicy]
+// @Failure 500
+func (rh *RetentionHandler) SetRetentionPolicy(ctx *gin.Context) {
+	var dto dto.RetentionPolicyDTO
+	outcome := ctx.Param("outcome")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	policy, err := rh.retentionService.SetRetentionPolicy(outcome, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": policy})
+}
+
+// DeleteRetentionPolicy godoc
+// @Tags Retention
+// @Summary 보존 정책 삭제
+// @Description 관리자가 보존 정책 삭제
+// @ID DeleteRetentionPolicy
+// @Accept  json
+// @Produce  json
+// @Param outcome path string true "지원 결과 (hire/reject/hold/undecided)"
+// @Router /retention-policies/{outcome} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (rh *RetentionHandler) DeleteRetentionPolicy(ctx *gin.Context) {
+	outcome := ctx.Param("outcome")
+
+	err := rh.retentionService.DeleteRetentionPolicy(outcome)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
+
+// ApplyRetentionPolicies godoc
+// @Tags Retention
+// @Summary 보존 정책 즉시 실행
+// @Description 관리자가 주기 작업을 기다리지 않고 보존 기간이 지난 JobApplication 익명화
+// @ID ApplyRetentionPolicies
+// @Accept  json
+// @Produce  json
+// @Router /retention-policies/run [post]
+// @Success 200 {object} dto.APIResponse[RetentionRunResult]
+// @Failure 500
+func (rh *RetentionHandler) ApplyRetentionPolicies(ctx *gin.Context) {
+	result, err := rh.retentionService.ApplyRetentionPolicies(ctx.Request.Context())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": result})
+}
+
+// GetErasureLogs godoc
+// @Tags Retention
+// @Summary 개인정보 삭제 기록 조회
+// @Description 관리자용 개인정보 익명화/삭제 기록 조회, 최신순
+// @ID GetErasureLogs
+// @Accept  json
+// @Produce  json
+// @Param from query string false "조회 시작 일시(RFC3339)"
+// @Param to query string false "조회 종료 일시(RFC3339)"
+// @Param reason query string false "삭제 사유(retention/manual)"
+// @Router /erasure-logs [get]
+// @Success 200 {object} dto.APIResponse[[]ErasureLog]
+// @Failure 500
+func (rh *RetentionHandler) GetErasureLogs(ctx *gin.Context) {
+	var query dto.ErasureLogQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	logs, err := rh.retentionService.GetErasureLogs(&query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": logs})
+}
+
+// EraseCandidate godoc
+// @Tags Retention
+// @Summary 지원자 개인정보 삭제
+// @Description 관리자가 지원자의 모든 JobApplication과 면접 Meeting을 익명화하고 지원자 정보를 삭제
+// @ID EraseCandidate
+// @Accept  json
+// @Produce  json
+// @Param candidateId path string true "Candidate ID"
+// @Router /candidates/{candidateId}/erase [post]
+// @Success 200 {object} dto.APIResponse[RetentionRunResult]
+// @Failure 500
+func (rh *RetentionHandler) EraseCandidate(ctx *gin.Context) {
+	candidateId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	result, err := rh.retentionService.EraseCandidate(ctx.Request.Context(), candidateId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": result})
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ScorecardHandler struct {
+	scorecardService services.ScorecardService
+}
+
+func NewScorecardHandler(scorecardService services.ScorecardService) ScorecardHandler {
+	return ScorecardHandler{scorecardService}
+}
+
+// GetScorecardByJobApplication godoc
+// @Tags Scorecard
+// @Summary JobApplication 평가표 조회
+// @Description JobApplication 평가표 조회, 채용 담당자가 아니면 본인 평가표를 제출한 후에만 조회 가능
+// @ID GetScorecardByJobApplication
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Router /jobApplications/{jobApplicationId}/scorecards [get]
+// @Success 200 {object} dto.APIResponse[[]Scorecard]
+// @Failure 500
+func (sh *ScorecardHandler) GetScorecardByJobApplication(ctx *gin.Context) {
+	jobApplicationId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	scorecards, err := sh.scorecardService.GetScorecardByJobApplication(jobApplicationId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": scorecards})
+}
+
+// GetScorecardSummary godoc
+// @Tags Scorecard
+// @Summary JobApplication 평가 요약 조회
+// @Description 평균 점수, 항목별 평균, 추천 의견 분포와 종합 의견, 채용 결정 조회 (평가표 조회와 같은 공개 조건)
+// @ID GetScorecardSummary
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Router /jobApplications/{jobApplicationId}/scorecards/summary [get]
+// @Success 200 {object} dto.APIResponse[ScorecardSummary]
+// @Failure 500
+func (sh *ScorecardHandler) GetScorecardSummary(ctx *gin.Context) {
+	jobApplicationId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	summary, err := sh.scorecardService.GetScorecardSummary(jobApplicationId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": summary})
+}
+
+// SubmitScorecard godoc
+// @Tags Scorecard
+// @Summary 평가표 제출
+// @Description 면접관이 포지션 템플릿의 모든 항목을 평가하여 제출, 제출한 평가표는 수정할 수 없고 다시 제출하면 409
+// @ID SubmitScorecard
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Param scorecard body dto.ScorecardSubmitDTO true "평가표 정보"
+// @Router /jobApplications/{jobApplicationId}/scorecards [post]
+// @Success 200 {object} dto.APIResponse[Scorecard]
+// @Failure 500
+func (sh *ScorecardHandler) SubmitScorecard(ctx *gin.Context) {
+	var dto dto.ScorecardSubmitDTO
+	jobApplicationId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	scorecard, err := sh.scorecardService.SubmitScorecard(jobApplicationId, &dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": scorecard})
+}
+
+// DecideJobApplication godoc
+// @Tags Scorecard
+// @Summary 채용 결정
+// @Description 채용 담당자나 관리자가 채용 결정(hire/reject/hold)을 기록, 채용 담당자가 없는 지원서는 관리자만 결정 가능
+// @ID DecideJobApplication
+// @Accept  json
+// @Produce  json
+// @Param jobApplicationId path string true "JobApplication ID"
+// @Param decision body dto.HiringDecisionDTO true "채용 결정"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /jobApplications/{jobApplicationId}/decision [put]
+// @Success 200 {object} dto.APIResponse[JobApplication]
+// @Failure 500
+func (sh *ScorecardHandler) DecideJobApplication(ctx *gin.Context) {
+	var dto dto.HiringDecisionDTO
+	jobApplicationId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	jobApplication, err := sh.scorecardService.DecideJobApplication(ctx.Request.Context(), jobApplicationId, &dto, currentUser.ID.Hex(), currentUser.IsAdmin())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, jobApplication.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": jobApplication})
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ScorecardTemplateHandler struct {
+	scorecardTemplateService services.ScorecardTemplateService
+}
+
+func NewScorecardTemplateHandler(scorecardTemplateService services.ScorecardTemplateService) ScorecardTemplateHandler {
+	return ScorecardTemplateHandler{scorecardTemplateService}
+}
+
+// GetAllScorecardTemplate godoc
+// @Tags ScorecardTemplate
+// @Summary 전체 평가표 템플릿 조회
+// @Description 전체 평가표 템플릿 조회
+// @ID GetAllScorecardTemplate
+// @Accept  json
+// @Produce  json
+// @Router /scorecard-templates [get]
+// @Success 200 {object} dto.APIResponse[[]ScorecardTemplate]
+// @Failure 500
+func (sth *ScorecardTemplateHandler) GetAllScorecardTemplate(ctx *gin.Context) {
+	templates, err := sth.scorecardTemplateService.GetAllScorecardTemplate()
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": templates})
+}
+
+// GetScorecardTemplate godoc
+// @Tags ScorecardTemplate
+// @Summary 평가표 템플릿 조회
+// @Description 평가표 템플릿 조회
+// @ID GetScorecardTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ScorecardTemplate ID"
+// @Router /scorecard-templates/{templateId} [get]
+// @Success 200 {object} dto.APIResponse[ScorecardTemplate]
+// @Failure 500
+func (sth *ScorecardTemplateHandler) GetScorecardTemplate(ctx *gin.Context) {
+	templateId := ctx.Param("id")
+
+	template, err := sth.scorecardTemplateService.GetScorecardTemplate(templateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	if template != nil {
+		setETag(ctx, template.Version)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": template})
+}
+
+// CreateScorecardTemplate godoc
+// @Tags ScorecardTemplate
+// @Summary 평가표 템플릿 생성
+// @Description 관리자가 포지션별 평가 항목과 점수 범위 정의, 포지션당 하나만 생성 가능
+// @ID CreateScorecardTemplate
+// @Accept  json
+// @Produce  json
+// @Param template body dto.ScorecardTemplateCreateDTO true "평가표 템플릿 정보"
+// @Router /scorecard-templates [post]
+// @Success 200 {object} dto.APIResponse[ScorecardTemplate]
+// @Failure 500
+func (sth *ScorecardTemplateHandler) CreateScorecardTemplate(ctx *gin.Context) {
+	var dto dto.ScorecardTemplateCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	template, err := sth.scorecardTemplateService.CreateScorecardTemplate(&dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": template})
+}
+
+// UpdateScorecardTemplate godoc
+// @Tags ScorecardTemplate
+// @Summary 평가표 템플릿 수정
+// @Description 관리자가 평가표 템플릿 수정, criteria를 전달하면 항목 전체를 교체
+// @ID UpdateScorecardTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ScorecardTemplate ID"
+// @Param template body dto.ScorecardTemplateUpdateDTO true "평가표 템플릿 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /scorecard-templates/{templateId} [patch]
+// @Success 200 {object} dto.APIResponse[ScorecardTemplate]
+// @Failure 500
+func (sth *ScorecardTemplateHandler) UpdateScorecardTemplate(ctx *gin.Context) {
+	var dto dto.ScorecardTemplateUpdateDTO
+	templateId := ctx.Param("id")
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	template, err := sth.scorecardTemplateService.UpdateScorecardTemplate(ctx.Request.Context(), templateId, &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, template.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": template})
+}
+
+// DeleteScorecardTemplate godoc
+// @Tags ScorecardTemplate
+// @Summary 평가표 템플릿 삭제
+// @Description 관리자가 평가표 템플릿 삭제
+// @ID DeleteScorecardTemplate
+// @Accept  json
+// @Produce  json
+// @Param templateId path string true "ScorecardTemplate ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /scorecard-templates/{templateId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (sth *ScorecardTemplateHandler) DeleteScorecardTemplate(ctx *gin.Context) {
+	templateId := ctx.Param("id")
+
+	err := sth.scorecardTemplateService.DeleteScorecardTemplate(ctx.Request.Context(), templateId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
@@ -0,0 +1,321 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TimeEntryHandler struct {
+	timeEntryService services.TimeEntryService
+}
+
+func NewTimeEntryHandler(timeEntryService services.TimeEntryService) TimeEntryHandler {
+	return TimeEntryHandler{timeEntryService}
+}
+
+// GetMyTimeEntry godoc
+// @Tags TimeEntry
+// @Summary 내 작업 시간 기록 조회
+// @Description 로그인한 유저의 작업 시간 기록 조회 (실행 중인 타이머 포함)
+// @ID GetMyTimeEntry
+// @Accept  json
+// @Produce  json
+// @Param from query string false "조회 시작 일시(RFC3339)"
+// @Param to query string false "조회 종료 일시(RFC3339)"
+// @Router /time-entries [get]
+// @Success 200 {object} dto.APIResponse[[]TimeEntry]
+// @Failure 500
+func (teh *TimeEntryHandler) GetMyTimeEntry(ctx *gin.Context) {
+	var query dto.TimeEntryQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	entries, err := teh.timeEntryService.GetTimeEntryByUser(currentUser.ID.Hex(), &query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": entries})
+}
+
+// StartTimer godoc
+// @Tags TimeEntry
+// @Summary 타이머 시작
+// @Description Project Task 또는 Todo의 타이머 시작, 유저당 실행 중인 타이머는 하나만 가능(409)
+// @ID StartTimer
+// @Accept  json
+// @Produce  json
+// @Param timer body dto.TimeEntryStartDTO true "타이머 정보"
+// @Router /time-entries/start [post]
+// @Success 200 {object} dto.APIResponse[TimeEntry]
+// @Failure 500
+func (teh *TimeEntryHandler) StartTimer(ctx *gin.Context) {
+	var dto dto.TimeEntryStartDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	entry, err := teh.timeEntryService.StartTimer(&dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": entry})
+}
+
+// StopTimer godoc
+// @Tags TimeEntry
+// @Summary 타이머 정지
+// @Description 로그인한 유저의 실행 중인 타이머를 정지하고 기록된 시간을 반환
+// @ID StopTimer
+// @Accept  json
+// @Produce  json
+// @Router /time-entries/stop [post]
+// @Success 200 {object} dto.APIResponse[TimeEntry]
+// @Failure 500
+func (teh *TimeEntryHandler) StopTimer(ctx *gin.Context) {
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	entry, err := teh.timeEntryService.StopTimer(currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": entry})
+}
+
+// CreateTimeEntry godoc
+// @Tags TimeEntry
+// @Summary 작업 시간 직접 입력
+// @Description Project Task 또는 Todo의 작업 시간 직접 입력
+// @ID CreateTimeEntry
+// @Accept  json
+// @Produce  json
+// @Param entry body dto.TimeEntryCreateDTO true "작업 시간 정보"
+// @Router /time-entries [post]
+// @Success 200 {object} dto.APIResponse[TimeEntry]
+// @Failure 500
+func (teh *TimeEntryHandler) CreateTimeEntry(ctx *gin.Context) {
+	var dto dto.TimeEntryCreateDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	entry, err := teh.timeEntryService.CreateTimeEntry(&dto, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": entry})
+}
+
+// DeleteTimeEntry godoc
+// @Tags TimeEntry
+// @Summary 작업 시간 기록 삭제
+// @Description 본인의 작업 시간 기록 삭제
+// @ID DeleteTimeEntry
+// @Accept  json
+// @Produce  json
+// @Param timeEntryId path string true "TimeEntry ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /time-entries/{timeEntryId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (teh *TimeEntryHandler) DeleteTimeEntry(ctx *gin.Context) {
+	entryId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := teh.timeEntryService.DeleteTimeEntry(ctx.Request.Context(), entryId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
+
+// GetTimeReport godoc
+// @Tags TimeEntry
+// @Summary 작업 시간 리포트
+// @Description 기간 내 작업 시간을 Project, 유저 또는 부서별로 집계, format=csv이면 CSV 파일로 반환
+// @ID GetTimeReport
+// @Accept  json
+// @Produce  json,text/csv
+// @Param from query string true "조회 시작 일시(RFC3339)"
+// @Param to query string true "조회 종료 일시(RFC3339)"
+// @Param group_by query string false "project(기본값), user, department"
+// @Param format query string false "csv"
+// @Router /time-entries/report [get]
+// @Success 200 {object} dto.APIResponse[[]TimeReportRow]
+// @Failure 500
+func (teh *TimeEntryHandler) GetTimeReport(ctx *gin.Context) {
+	var query dto.TimeReportQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	rows, err := teh.timeEntryService.GetTimeReport(&query, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	if query.Format == "csv" {
+		writeTimeReportCSV(ctx, rows)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": rows})
+}
+
+// GetProjectTimeEstimates godoc
+// @Tags TimeEntry
+// @Summary Project 예상/실제 작업 시간 비교
+// @Description Project Task/Todo별 예상 시간과 기록된 실제 시간 비교
+// @ID GetProjectTimeEstimates
+// @Accept  json
+// @Produce  json
+// @Param projectId path string true "Project ID"
+// @Router /time-entries/projects/{projectId}/estimates [get]
+// @Success 200 {object} dto.APIResponse[[]TimeEstimate]
+// @Failure 500
+func (teh *TimeEntryHandler) GetProjectTimeEstimates(ctx *gin.Context) {
+	projectId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	estimates, err := teh.timeEntryService.GetProjectTimeEstimates(projectId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": estimates})
+}
+
+// writeTimeReportCSV 작업 시간 리포트를 CSV 파일로 응답
+func writeTimeReportCSV(ctx *gin.Context, rows []models.TimeReportRow) {
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "time-report.csv"))
+	ctx.Status(http.StatusOK)
+
+	writer := csv.NewWriter(ctx.Writer)
+	writer.Write([]string{"id", "name", "entries", "minutes", "hours"})
+	for _, row := range rows {
+		writer.Write([]string{
+			row.ID.Hex(),
+			row.Name,
+			strconv.Itoa(row.Entries),
+			strconv.FormatInt(row.Minutes, 10),
+			strconv.FormatFloat(row.Hours, 'f', 2, 64),
+		})
+	}
+	writer.Flush()
+}
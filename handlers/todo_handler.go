@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -28,7 +29,9 @@ func NewTodoHandler(todoService services.TodoService) TodoHandler {
 // @Success 200 {object} dto.APIResponse[[]Todo]
 // @Failure 500
 func (th *TodoHandler) GetAllTodo(ctx *gin.Context) {
-	todos, err := th.todoService.GetAllTodo()
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	todos, err := th.todoService.GetAllTodo(currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -60,7 +63,9 @@ func (th *TodoHandler) GetAllTodo(ctx *gin.Context) {
 func (th *TodoHandler) GetTodo(ctx *gin.Context) {
 	todoId := ctx.Param("id")
 
-	todo, err := th.todoService.GetTodo(todoId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	todo, err := th.todoService.GetTodo(todoId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -75,6 +80,10 @@ func (th *TodoHandler) GetTodo(ctx *gin.Context) {
 		}
 	}
 
+	if todo != nil {
+		setETag(ctx, todo.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": todo})
 }
 
@@ -92,7 +101,9 @@ func (th *TodoHandler) GetTodo(ctx *gin.Context) {
 func (th *TodoHandler) GetTodoByUser(ctx *gin.Context) {
 	userId := ctx.Param("id")
 
-	todos, err := th.todoService.GetTodoByUser(userId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	todos, err := th.todoService.GetTodoByUser(userId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -136,7 +147,9 @@ func (th *TodoHandler) CreateTodo(ctx *gin.Context) {
 		return
 	}
 
-	err := th.todoService.CreateTodo(&dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := th.todoService.CreateTodo(ctx.Request.Context(), &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -163,6 +176,7 @@ func (th *TodoHandler) CreateTodo(ctx *gin.Context) {
 // @Produce  json
 // @Param todoId path string true "Todo ID"
 // @Param todo body dto.TodoUpdateDTO true "Todo 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /todos/{todoId} [patch]
 // @Success 200 {object} dto.APIResponse[Todo]
 // @Failure 500
@@ -182,7 +196,9 @@ func (th *TodoHandler) UpdateTodo(ctx *gin.Context) {
 		return
 	}
 
-	todo, err := th.todoService.UpdateTodo(todoId, &dto)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	todo, err := th.todoService.UpdateTodo(ctx.Request.Context(), todoId, &dto, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -197,24 +213,29 @@ func (th *TodoHandler) UpdateTodo(ctx *gin.Context) {
 		}
 	}
 
+	setETag(ctx, todo.Version)
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": todo})
 }
 
 // DeleteTodo godoc
 // @Tags Todo
 // @Summary Todo 삭제
-// @Description Todo 삭제
+// @Description Todo를 휴지통으로 이동
 // @ID DeleteTodo
 // @Accept  json
 // @Produce  json
 // @Param todoId path string true "Todo ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
 // @Router /todos/{todoId} [delete]
 // @Success 200 {object} dto.APIResponseWithoutData
 // @Failure 500
 func (th *TodoHandler) DeleteTodo(ctx *gin.Context) {
 	todoId := ctx.Param("id")
 
-	err := th.todoService.DeleteTodo(todoId)
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := th.todoService.DeleteTodo(ctx.Request.Context(), todoId, currentUser.ID.Hex())
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
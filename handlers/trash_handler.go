@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler struct {
+	trashService services.TrashService
+}
+
+func NewTrashHandler(trashService services.TrashService) TrashHandler {
+	return TrashHandler{trashService}
+}
+
+// GetTrash godoc
+// @Tags Trash
+// @Summary 휴지통 조회
+// @Description 직접 삭제했거나 소유한 항목 조회, 삭제 일시 최신순. Project와 함께 삭제된 Task/Todo는 Project를 복원하면 함께 복원되므로 제외
+// @ID GetTrash
+// @Accept  json
+// @Produce  json
+// @Param resource query string false "리소스 (note, todo, project, project_task, meeting, job_application)"
+// @Router /trash [get]
+// @Success 200 {object} dto.APIResponse[[]TrashItem]
+// @Failure 500
+func (th *TrashHandler) GetTrash(ctx *gin.Context) {
+	var query dto.TrashQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	items, err := th.trashService.GetTrash(&query, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": items})
+}
+
+// RestoreTrashItem godoc
+// @Tags Trash
+// @Summary 휴지통 항목 복원
+// @Description 휴지통 항목 복원, Project를 복원하면 함께 삭제된 Task/Todo도 복원. 휴지통에 있는 Project의 Task/Todo는 복원 불가(409)
+// @ID RestoreTrashItem
+// @Accept  json
+// @Produce  json
+// @Param resource path string true "리소스 (note, todo, project, project_task, meeting, job_application)"
+// @Param id path string true "리소스 ID"
+// @Router /trash/{resource}/{id}/restore [post]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (th *TrashHandler) RestoreTrashItem(ctx *gin.Context) {
+	resource := ctx.Param("resource")
+	id := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := th.trashService.RestoreTrashItem(ctx.Request.Context(), resource, id, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/services"
 	"github.com/gin-gonic/gin"
 )
@@ -20,15 +21,68 @@ func NewUserHandler(userService services.UserService) UserHandler {
 // GetAllUser godoc
 // @Tags User
 // @Summary 전체 유저 조회
-// @Description 전체 유저 조회
+// @Description 유저 목록 조회, 이름/이메일 앞부분 검색, 부서(하위 부서 포함), 직책으로 필터링
 // @ID GetAllUser
 // @Accept  json
 // @Produce  json
+// @Param q query string false "이름/이메일 앞부분"
+// @Param department query string false "Department ID (하위 부서 포함)"
+// @Param position query string false "직책"
+// @Param include_deactivated query bool false "비활성화된 유저 포함 여부"
+// @Param limit query int false "최대 개수 (최대 100)"
 // @Router /users [get]
 // @Success 200 {object} dto.APIResponse[[]User]
 // @Failure 500
 func (uh *UserHandler) GetAllUser(ctx *gin.Context) {
-	users, err := uh.userService.GetAllUser()
+	var query dto.UserQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	users, err := uh.userService.GetAllUser(&query)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": users})
+}
+
+// SearchUsers godoc
+// @Tags User
+// @Summary 유저 검색 (선택용)
+// @Description 참석자/담당자 선택용 유저 검색, id/이름/사진/직책만 반환하며 기본 20명
+// @ID SearchUsers
+// @Accept  json
+// @Produce  json
+// @Param q query string false "이름/이메일 앞부분"
+// @Param department query string false "Department ID (하위 부서 포함)"
+// @Param position query string false "직책"
+// @Param include_deactivated query bool false "비활성화된 유저 포함 여부"
+// @Param limit query int false "최대 개수 (최대 100)"
+// @Router /users/search [get]
+// @Success 200 {object} dto.APIResponse[[]UserSummary]
+// @Failure 500
+func (uh *UserHandler) SearchUsers(ctx *gin.Context) {
+	var query dto.UserQueryDTO
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	users, err := uh.userService.SearchUsers(&query)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -75,13 +129,17 @@ func (uh *UserHandler) GetUser(ctx *gin.Context) {
 		}
 	}
 
+	if users != nil {
+		setETag(ctx, users.Version)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": users})
 }
 
 // CreateUser godoc
 // @Tags User
 // @Summary 유저 생성
-// @Description 유저 생성
+// @Description 관리자가 유저 생성, 이메일 인증 여부와 가입 경로는 지정할 수 없음
 // @ID CreateUser
 // @Accept  json
 // @Produce  json
@@ -104,7 +162,7 @@ func (uh *UserHandler) CreateUser(ctx *gin.Context) {
 		return
 	}
 
-	err := uh.userService.CreateUser(&dto)
+	err := uh.userService.CreateUser(ctx.Request.Context(), &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -125,7 +183,7 @@ func (uh *UserHandler) CreateUser(ctx *gin.Context) {
 // UpsertUser godoc
 // @Tags User
 // @Summary 유저 Upsert
-// @Description 유저 생성 or 업데이트
+// @Description 관리자가 유저 생성 or 업데이트, 이메일 인증 여부와 가입 경로는 바꿀 수 없음
 // @ID UpsertUser
 // @Accept  json
 // @Produce  json
@@ -148,7 +206,7 @@ func (uh *UserHandler) UpsertUser(ctx *gin.Context) {
 		return
 	}
 
-	result, err := uh.userService.UpsertUser(&dto)
+	result, err := uh.userService.UpsertUser(ctx.Request.Context(), &dto)
 
 	if err != nil {
 		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
@@ -165,3 +223,165 @@ func (uh *UserHandler) UpsertUser(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": result})
 }
+
+// UpdateMe godoc
+// @Tags User
+// @Summary 내 프로필 수정
+// @Description 로그인한 유저의 이름, 직책, 부서, 사진 수정
+// @ID UpdateMe
+// @Accept  json
+// @Produce  json
+// @Param profile body dto.UserProfileDTO true "프로필 정보"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /me [patch]
+// @Success 200 {object} dto.APIResponse[User]
+// @Failure 500
+func (uh *UserHandler) UpdateMe(ctx *gin.Context) {
+	var dto dto.UserProfileDTO
+
+	//validate the request body
+	if err := ctx.BindJSON(&dto); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	//use the validator library to validate required fields
+	if validationErr := validate.Struct(&dto); validationErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": validationErr.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	user, err := uh.userService.UpdateMe(ctx.Request.Context(), currentUser.ID.Hex(), &dto)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, user.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": user})
+}
+
+// DeactivateUser godoc
+// @Tags User
+// @Summary 유저 비활성화
+// @Description 관리자가 유저를 비활성화, 비활성화된 유저는 로그인과 API 사용이 막힘
+// @ID DeactivateUser
+// @Accept  json
+// @Produce  json
+// @Param userId path string true "User ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /users/{userId}/deactivate [patch]
+// @Success 200 {object} dto.APIResponse[User]
+// @Failure 500
+func (uh *UserHandler) DeactivateUser(ctx *gin.Context) {
+	userId := ctx.Param("id")
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	user, err := uh.userService.DeactivateUser(ctx.Request.Context(), userId, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, user.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": user})
+}
+
+// ReactivateUser godoc
+// @Tags User
+// @Summary 유저 재활성화
+// @Description 관리자가 비활성화된 유저를 재활성화
+// @ID ReactivateUser
+// @Accept  json
+// @Produce  json
+// @Param userId path string true "User ID"
+// @Param If-Match header string false "조회 시 받은 ETag, 다르면 412"
+// @Router /users/{userId}/reactivate [patch]
+// @Success 200 {object} dto.APIResponse[User]
+// @Failure 500
+func (uh *UserHandler) ReactivateUser(ctx *gin.Context) {
+	userId := ctx.Param("id")
+
+	user, err := uh.userService.ReactivateUser(ctx.Request.Context(), userId)
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	setETag(ctx, user.Version)
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully", "data": user})
+}
+
+// DeleteUser godoc
+// @Tags User
+// @Summary 유저 삭제
+// @Description 관리자가 유저 삭제, reassign_to가 있으면 Note/Todo/생성한 Meeting/담당 Project Task/액션 아이템/댓글/작업 시간과 Project 소유권·멤버 역할을 넘기고, 없으면 Note/Todo/Meeting/댓글/작업 시간은 삭제하고 Project Task·액션 아이템 담당자는 비우며 Project 멤버에서 뺌, 소유한 Project가 있는데 reassign_to가 없으면 409
+// @ID DeleteUser
+// @Accept  json
+// @Produce  json
+// @Param userId path string true "User ID"
+// @Param reassign_to query string false "데이터를 넘겨받을 User ID"
+// @Router /users/{userId} [delete]
+// @Success 200 {object} dto.APIResponseWithoutData
+// @Failure 500
+func (uh *UserHandler) DeleteUser(ctx *gin.Context) {
+	var query dto.UserDeleteDTO
+	userId := ctx.Param("id")
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	currentUser := ctx.MustGet("currentUser").(models.User)
+
+	err := uh.userService.DeleteUser(ctx.Request.Context(), userId, &query, currentUser.ID.Hex())
+
+	if err != nil {
+		// CustomError 인터페이스로 형변환이 성공하면 customErr에는 *errors.CustomError 타입의 값이 할당되고, ok 변수에는 true가 할당
+		customErr, ok := err.(*errors.CustomError)
+		if ok {
+			statusCode := customErr.Status()
+			ctx.JSON(statusCode, gin.H{"err": customErr.Err.Error(), "message": customErr.Error()})
+			return
+		} else {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"code": http.StatusOK, "message": "successfully"})
+}
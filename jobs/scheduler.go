@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// Every runs fn every interval in a goroutine until ctx is done.
+// A failed run is only logged and retried on the next tick.
+func Every(ctx context.Context, name string, interval time.Duration, fn func() error) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := fn(); err != nil {
+					log.Printf("job %s failed: %v", name, err)
+				}
+			}
+		}
+	}()
+}
+
+// Interval reads a duration (e.g. "24h") from the environment, falling back to def when unset or invalid.
+func Interval(key string, def time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			return interval
+		}
+		log.Printf("invalid %s=%q, using %s", key, value, def)
+	}
+	return def
+}
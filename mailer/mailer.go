@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"net/smtp"
+	"os"
+	"strings"
+)
+
+// Mailer sends a plain-text email.
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+// SMTPMailer delivers mail through an SMTP server. Authentication is skipped
+// when no username is configured, which suits local stand-ins like MailHog.
+type SMTPMailer struct {
+	addr     string
+	username string
+	password string
+	host     string
+	from     string
+}
+
+func NewSMTPMailer(host string, port string, username string, password string, from string) *SMTPMailer {
+	return &SMTPMailer{fmt.Sprint(host, ":", port), username, password, host, from}
+}
+
+func (m *SMTPMailer) Send(to string, subject string, body string) error {
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	msg := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	return smtp.SendMail(m.addr, auth, m.from, []string{to}, []byte(msg))
+}
+
+// LogMailer only logs the recipient and subject. Used when SMTP is not configured.
+// The body is never logged because it carries live verification/reset links.
+type LogMailer struct{}
+
+func (LogMailer) Send(to string, subject string, body string) error {
+	log.Printf("mail to %s: %s (body omitted, SMTP not configured)", to, subject)
+	return nil
+}
+
+// FromEnv builds an SMTPMailer from SMTP_HOST, SMTP_PORT (default 25),
+// SMTP_USERNAME, SMTP_PASSWORD and MAIL_FROM, or a LogMailer when SMTP_HOST is unset.
+func FromEnv() Mailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return LogMailer{}
+	}
+
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "25"
+	}
+
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+
+	return NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from)
+}
@@ -1,11 +1,11 @@
 package middleware
 
 import (
-	"fmt"
 	"net/http"
 	"os"
 	"strings"
 
+	"github.com/Kim-DaeHan/all-note-golang/audit"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 	"github.com/Kim-DaeHan/all-note-golang/utils"
 	"github.com/gin-gonic/gin"
@@ -54,8 +54,12 @@ func DeserializeUser(collection *mongo.Collection) gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user belonging to this token no logger exists"})
 			return
 		}
-		fmt.Println("user: ", user)
+		if !user.IsActive() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user belonging to this token is deactivated"})
+			return
+		}
 		ctx.Set("currentUser", user)
+		ctx.Request = ctx.Request.WithContext(audit.WithActor(ctx.Request.Context(), user.ID))
 		ctx.Next()
 
 	}
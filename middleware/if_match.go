@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/etag"
+	"github.com/gin-gonic/gin"
+)
+
+// IfMatch 수정/삭제 요청의 If-Match 헤더를 요청 context에 저장, 서비스가 update 조건에 version으로 반영
+func IfMatch() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		switch ctx.Request.Method {
+		case http.MethodPatch, http.MethodPut, http.MethodDelete:
+		default:
+			ctx.Next()
+			return
+		}
+
+		versions, err := etag.Parse(ctx.GetHeader("If-Match"))
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "유효하지 않은 If-Match 헤더", "err": err.Error()})
+			return
+		}
+
+		if versions != nil {
+			ctx.Request = ctx.Request.WithContext(etag.WithIfMatch(ctx.Request.Context(), versions))
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Kim-DaeHan/all-note-golang/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID 요청마다 X-Request-ID를 부여하고 요청 context에 요청 ID와 IP를 저장 (감사 로그용)
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestId := ctx.GetHeader("X-Request-ID")
+		if requestId == "" || len(requestId) > 64 {
+			b := make([]byte, 16)
+			rand.Read(b)
+			requestId = hex.EncodeToString(b)
+		}
+
+		ctx.Set("requestId", requestId)
+		ctx.Header("X-Request-ID", requestId)
+		ctx.Request = ctx.Request.WithContext(audit.WithRequest(ctx.Request.Context(), requestId, ctx.ClientIP()))
+
+		ctx.Next()
+	}
+}
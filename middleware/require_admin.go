@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin DeserializeUser 뒤에서 관리자만 통과시킴
+func RequireAdmin() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		currentUser := ctx.MustGet("currentUser").(models.User)
+
+		if !currentUser.IsAdmin() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "admin permission required"})
+			return
+		}
+
+		ctx.Next()
+	}
+}
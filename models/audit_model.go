@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 감사 로그 대상 리소스
+const (
+	AuditResourceNote           = "note"
+	AuditResourceTodo           = "todo"
+	AuditResourceProject        = "project"
+	AuditResourceProjectTask    = "project_task"
+	AuditResourceMeeting        = "meeting"
+	AuditResourceJobApplication = "job_application"
+	AuditResourceUser           = "user"
+)
+
+// 감사 로그 작업
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+	// 휴지통에서 복원
+	AuditActionRestore = "restore"
+	// 보관 기간이 지나 휴지통에서 영구 삭제
+	AuditActionPurge = "purge"
+)
+
+// AuditLog info
+// @Description 변경 이력, seq 순서로 이전 항목의 hash를 이어 붙여 위변조를 확인
+type AuditLog struct {
+	ID         primitive.ObjectID  `bson:"_id" json:"id"`
+	Seq        int64               `bson:"seq" json:"seq"`
+	Resource   string              `bson:"resource" json:"resource"`
+	ResourceID primitive.ObjectID  `bson:"resource_id" json:"resource_id"`
+	Action     string              `bson:"action" json:"action"`
+	Actor      *primitive.ObjectID `bson:"actor,omitempty" json:"actor,omitempty"`
+	RequestID  string              `bson:"request_id,omitempty" json:"request_id,omitempty"`
+	IP         string              `bson:"ip,omitempty" json:"ip,omitempty"`
+	Changes    []AuditChange       `bson:"changes" json:"changes"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+	PrevHash   string              `bson:"prev_hash" json:"prev_hash"`
+	Hash       string              `bson:"hash" json:"hash"`
+} //@name AuditLog
+
+// AuditHead 감사 로그 체인의 마지막 항목, 서버 키로 서명하여 뒤쪽 항목이 삭제되었는지 확인
+type AuditHead struct {
+	ID        string    `bson:"_id"`
+	Seq       int64     `bson:"seq"`
+	Hash      string    `bson:"hash"`
+	Signature string    `bson:"signature"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// AuditChange info
+// @Description 필드 단위 변경 전/후 값, 생성은 before가 없고 삭제는 after가 없음
+type AuditChange struct {
+	Field  string      `bson:"field" json:"field"`
+	Before interface{} `bson:"before,omitempty" json:"before,omitempty"`
+	After  interface{} `bson:"after,omitempty" json:"after,omitempty"`
+} //@name AuditChange
+
+// AuditVerification info
+// @Description 감사 로그 hash 체인 검증 결과, 깨진 경우 처음 어긋난 seq
+type AuditVerification struct {
+	Valid    bool   `json:"valid"`
+	Checked  int64  `json:"checked"`
+	BrokenAt *int64 `json:"broken_at,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+} //@name AuditVerification
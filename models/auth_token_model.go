@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 인증 토큰 용도
+const (
+	AuthTokenEmailVerification = "email_verification"
+	AuthTokenPasswordReset     = "password_reset"
+)
+
+// AuthToken info
+// @Description 이메일 인증/비밀번호 재설정용 일회용 토큰, 원문 대신 해시만 저장
+type AuthToken struct {
+	ID        primitive.ObjectID `bson:"_id" json:"id"`
+	User      primitive.ObjectID `bson:"user" json:"user"`
+	Purpose   string             `bson:"purpose" json:"purpose"`
+	TokenHash string             `bson:"token_hash" json:"-"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty" json:"used_at,omitempty"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+} //@name AuthToken
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Candidate info
+// @Description 지원자 정보, 여러 JobApplication이 같은 지원자를 참조
+type Candidate struct {
+	ID    primitive.ObjectID `bson:"_id" json:"id"`
+	Name  string             `bson:"name" json:"name"`
+	Email string             `bson:"email,omitempty" json:"email,omitempty"`
+	Phone string             `bson:"phone,omitempty" json:"phone,omitempty"`
+	Links []string           `bson:"links,omitempty" json:"links,omitempty"`
+	// 중복 검사용 정규화 값
+	NameKey  string `bson:"name_key" json:"-"`
+	PhoneKey string `bson:"phone_key,omitempty" json:"-"`
+	// 생성 시 찾은 중복 의심 지원자
+	Duplicates []CandidateMatch `bson:"-" json:"possible_duplicates,omitempty"`
+	Version    int64            `bson:"version" json:"version"`
+	CreatedAt  time.Time        `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time        `bson:"updated_at" json:"updated_at"`
+} //@name Candidate
+
+// CandidateMatch info
+// @Description 중복 의심 지원자와 일치 근거(email/phone/name), score는 0~1
+type CandidateMatch struct {
+	Candidate Candidate `json:"candidate"`
+	Score     float64   `json:"score"`
+	Reasons   []string  `json:"reasons"`
+} //@name CandidateMatch
+
+// CandidateProfile info
+// @Description 지원자 정보와 지원 이력
+type CandidateProfile struct {
+	Candidate
+	Applications []JobApplication `json:"applications"`
+} //@name CandidateProfile
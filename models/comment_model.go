@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Comment 대상 종류
+const (
+	CommentTargetProjectTask    = "project_task"
+	CommentTargetTodo           = "todo"
+	CommentTargetJobApplication = "job_application"
+)
+
+// Comment info
+// @Description Project Task, Todo, JobApplication에 남기는 댓글
+type Comment struct {
+	ID         primitive.ObjectID   `bson:"_id" json:"id"`
+	TargetType string               `bson:"target_type" json:"target_type"`
+	Target     primitive.ObjectID   `bson:"target" json:"target"`
+	Author     primitive.ObjectID   `bson:"author" json:"author"`
+	AuthorInfo []manager            `bson:"author_info,omitempty" json:"author_info,omitempty"`
+	Content    string               `bson:"content" json:"content"`
+	Mentions   []primitive.ObjectID `bson:"mentions,omitempty" json:"mentions,omitempty"`
+	EditedAt   *time.Time           `bson:"edited_at,omitempty" json:"edited_at,omitempty"`
+	Version    int64                `bson:"version" json:"version"`
+	CreatedAt  time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time            `bson:"updated_at" json:"updated_at"`
+} //@name Comment
+
+// IsValidCommentTarget checks if the target type can have comments.
+func IsValidCommentTarget(targetType string) bool {
+	switch targetType {
+	case CommentTargetProjectTask, CommentTargetTodo, CommentTargetJobApplication:
+		return true
+	}
+	return false
+}
@@ -10,23 +10,87 @@ import (
 // JobApplication info
 // @Description JobApplication information
 type JobApplication struct {
-	ID             primitive.ObjectID `bson:"_id" json:"id"`
-	ApplicantName  string             `bson:"applicant_name" json:"applicant_name"`
-	User           primitive.ObjectID `bson:"manager" json:"manager"`
-	UserInfo       []jobUser          `bson:"manager_info,omitempty" json:"manager_info,omitempty"`
-	Department     primitive.ObjectID `bson:"department,omitempty" json:"department,omitempty"`
-	DepartmentInfo []Department       `bson:"department_info,omitempty" json:"department_info,omitempty"`
-	Position       string             `bson:"position" json:"position"`
-	Task           string             `bson:"task" json:"task"`
-	Stage          string             `bson:"stage" json:"stage"`
-	Location       string             `bson:"location,omitempty" json:"location,omitempty"`
-	StartDt        time.Time          `bson:"start_dt" json:"start_dt"`
-	EndDt          time.Time          `bson:"end_dt,omitempty" json:"end_dt,omitempty"`
-	Status         string             `bson:"status" json:"status"`
-	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+	ID             primitive.ObjectID  `bson:"_id" json:"id"`
+	ApplicantName  string              `bson:"applicant_name" json:"applicant_name"`
+	Candidate      primitive.ObjectID  `bson:"candidate,omitempty" json:"candidate,omitempty"`
+	User           primitive.ObjectID  `bson:"manager" json:"manager"`
+	UserInfo       []jobUser           `bson:"manager_info,omitempty" json:"manager_info,omitempty"`
+	Department     primitive.ObjectID  `bson:"department,omitempty" json:"department,omitempty"`
+	DepartmentInfo []Department        `bson:"department_info,omitempty" json:"department_info,omitempty"`
+	Position       string              `bson:"position" json:"position"`
+	Task           string              `bson:"task" json:"task"`
+	Stage          string              `bson:"stage" json:"stage"`
+	StageHistory   []StageChange       `bson:"stage_history,omitempty" json:"stage_history,omitempty"`
+	Location       string              `bson:"location,omitempty" json:"location,omitempty"`
+	StartDt        time.Time           `bson:"start_dt" json:"start_dt"`
+	EndDt          time.Time           `bson:"end_dt,omitempty" json:"end_dt,omitempty"`
+	Status         string              `bson:"status" json:"status"`
+	Attachments    []Attachment        `bson:"attachments,omitempty" json:"attachments,omitempty"`
+	Decision       *HiringDecision     `bson:"decision,omitempty" json:"decision,omitempty"`
+	CommentCount   int                 `bson:"comment_count,omitempty" json:"comment_count"`
+	AnonymizedAt   *time.Time          `bson:"anonymized_at,omitempty" json:"anonymized_at,omitempty"`
+	Version        int64               `bson:"version" json:"version"`
+	DeletedAt      *time.Time          `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy      *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	CreatedAt      time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name JobApplication
 
+// Attachment info
+// @Description JobApplication 첨부 파일 (이력서, 포트폴리오 등)
+type Attachment struct {
+	Name       string    `bson:"name" json:"name"`
+	URL        string    `bson:"url" json:"url"`
+	UploadedAt time.Time `bson:"uploaded_at" json:"uploaded_at"`
+} //@name Attachment
+
+// 익명화된 JobApplication의 지원자 이름
+const AnonymizedApplicantName = "(익명)"
+
+// AnonymizedFields 익명화 시 지우는 개인정보 필드
+var AnonymizedFields = []string{"applicant_name", "candidate", "attachments"}
+
+// StageChange info
+// @Description 채용 단계 이동 기록
+type StageChange struct {
+	Stage   string             `bson:"stage" json:"stage"`
+	From    string             `bson:"from,omitempty" json:"from,omitempty"`
+	MovedBy primitive.ObjectID `bson:"moved_by,omitempty" json:"moved_by,omitempty"`
+	MovedAt time.Time          `bson:"moved_at" json:"moved_at"`
+} //@name StageChange
+
+// StageDuration info
+// @Description 채용 단계별 체류 시간
+type StageDuration struct {
+	Stage   string  `json:"stage"`
+	Hours   float64 `json:"hours"`
+	Current bool    `json:"current,omitempty"`
+} //@name StageDuration
+
+// StageDurations 단계 이동 기록으로 단계별 체류 시간을 계산, 현재 단계는 now까지
+// 이동 기록이 없는 기존 지원서는 생성 시점부터 현재 단계에 있었던 것으로 봄
+func (j *JobApplication) StageDurations(now time.Time) []StageDuration {
+	history := j.StageHistory
+	if len(history) == 0 {
+		history = []StageChange{{Stage: j.Stage, MovedAt: j.CreatedAt}}
+	}
+
+	durations := make([]StageDuration, 0, len(history))
+	for i, change := range history {
+		end, current := now, i == len(history)-1
+		if !current {
+			end = history[i+1].MovedAt
+		}
+		durations = append(durations, StageDuration{
+			Stage:   change.Stage,
+			Hours:   end.Sub(change.MovedAt).Hours(),
+			Current: current,
+		})
+	}
+
+	return durations
+}
+
 // meetingUser info
 // @Description meetingUser information
 type jobUser struct {
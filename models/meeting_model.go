@@ -19,13 +19,172 @@ type Meeting struct {
 	Location     string             `bson:"location,omitempty" json:"location,omitempty"`
 	User         primitive.ObjectID `bson:"created_by" json:"created_by"`
 	UserInfo     []meetingUser      `bson:"created_by_info,omitempty" json:"created_by_info,omitempty"`
-	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+	RSVPSummary  *RSVPSummary       `bson:"-" json:"rsvp_summary,omitempty"`
+	Recurrence   *Recurrence        `bson:"recurrence,omitempty" json:"recurrence,omitempty"`
+	Exceptions   []MeetingException `bson:"exceptions,omitempty" json:"exceptions,omitempty"`
+	Minutes      *MeetingMinutes    `bson:"minutes,omitempty" json:"minutes,omitempty"`
+	Interview    *Interview         `bson:"interview,omitempty" json:"interview,omitempty"`
+	// 반복 Meeting을 기간 조회로 펼쳤을 때 해당 회차의 원래 시작 일시
+	OriginalStartDt *time.Time          `bson:"-" json:"original_start_dt,omitempty"`
+	Version         int64               `bson:"version" json:"version"`
+	DeletedAt       *time.Time          `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy       *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name Meeting
 
+// 반복 주기
+const (
+	FreqDaily   = "daily"
+	FreqWeekly  = "weekly"
+	FreqMonthly = "monthly"
+)
+
+// Recurrence info
+// @Description Meeting 반복 규칙
+type Recurrence struct {
+	Freq     string     `bson:"freq" json:"freq"`
+	Interval int        `bson:"interval" json:"interval"`
+	ByDay    []string   `bson:"by_day,omitempty" json:"by_day,omitempty"`
+	Until    *time.Time `bson:"until,omitempty" json:"until,omitempty"`
+	Count    int        `bson:"count,omitempty" json:"count,omitempty"`
+	// 요일/날짜 계산 기준 타임존 (예: Asia/Seoul), 없으면 UTC
+	TimeZone string `bson:"time_zone,omitempty" json:"time_zone,omitempty"`
+} //@name Recurrence
+
+// MeetingMinutes info
+// @Description Meeting 회의록 (안건, 결정 사항, 액션 아이템)
+type MeetingMinutes struct {
+	AgendaItems []AgendaItem `bson:"agenda_items,omitempty" json:"agenda_items,omitempty"`
+	Decisions   []string     `bson:"decisions,omitempty" json:"decisions,omitempty"`
+	ActionItems []ActionItem `bson:"action_items,omitempty" json:"action_items,omitempty"`
+	UpdatedAt   time.Time    `bson:"updated_at" json:"updated_at"`
+} //@name MeetingMinutes
+
+// AgendaItem info
+// @Description 회의 안건
+type AgendaItem struct {
+	Title string `bson:"title" json:"title"`
+	Notes string `bson:"notes,omitempty" json:"notes,omitempty"`
+} //@name AgendaItem
+
+// ActionItem info
+// @Description 회의 액션 아이템, Todo로 변환되면 Todo에 변환된 Todo ID가 기록됨
+type ActionItem struct {
+	ID          primitive.ObjectID  `bson:"_id" json:"id"`
+	Description string              `bson:"description" json:"description"`
+	Assignee    primitive.ObjectID  `bson:"assignee,omitempty" json:"assignee,omitempty"`
+	DueDt       *time.Time          `bson:"due_dt,omitempty" json:"due_dt,omitempty"`
+	Todo        *primitive.ObjectID `bson:"todo,omitempty" json:"todo,omitempty"`
+} //@name ActionItem
+
+// 면접 결과
+const (
+	InterviewOutcomePending = "pending"
+	InterviewOutcomePassed  = "passed"
+	InterviewOutcomeFailed  = "failed"
+	InterviewOutcomeNoShow  = "no_show"
+)
+
+// Interview info
+// @Description 면접 Meeting의 JobApplication 연결 정보와 결과
+type Interview struct {
+	JobApplication primitive.ObjectID `bson:"job_application" json:"job_application"`
+	Outcome        string             `bson:"outcome" json:"outcome"`
+	Note           string             `bson:"note,omitempty" json:"note,omitempty"`
+	DecidedBy      primitive.ObjectID `bson:"decided_by,omitempty" json:"decided_by,omitempty"`
+	DecidedAt      *time.Time         `bson:"decided_at,omitempty" json:"decided_at,omitempty"`
+} //@name Interview
+
+// IsValidInterviewOutcome 면접 결과로 기록할 수 있는 값인지 확인
+func IsValidInterviewOutcome(outcome string) bool {
+	switch outcome {
+	case InterviewOutcomePending, InterviewOutcomePassed, InterviewOutcomeFailed, InterviewOutcomeNoShow:
+		return true
+	}
+	return false
+}
+
+// MeetingException info
+// @Description 반복 Meeting의 특정 회차 취소/변경 정보
+type MeetingException struct {
+	OriginalStartDt time.Time  `bson:"original_start_dt" json:"original_start_dt"`
+	Cancelled       bool       `bson:"cancelled,omitempty" json:"cancelled,omitempty"`
+	Title           string     `bson:"title,omitempty" json:"title,omitempty"`
+	Description     string     `bson:"description,omitempty" json:"description,omitempty"`
+	Location        string     `bson:"location,omitempty" json:"location,omitempty"`
+	StartDt         *time.Time `bson:"start_dt,omitempty" json:"start_dt,omitempty"`
+	EndDt           *time.Time `bson:"end_dt,omitempty" json:"end_dt,omitempty"`
+} //@name MeetingException
+
+// 참석 응답(RSVP) 상태
+const (
+	RSVPPending   = "pending"
+	RSVPAccepted  = "accepted"
+	RSVPDeclined  = "declined"
+	RSVPTentative = "tentative"
+)
+
 type Participant struct {
-	User     primitive.ObjectID `bson:"participant,omitempty" json:"participant,omitempty"`
-	UserInfo []meetingUser      `bson:"participant_info,omitempty" json:"participant_info,omitempty"`
+	User        primitive.ObjectID `bson:"participant,omitempty" json:"participant,omitempty"`
+	UserInfo    []meetingUser      `bson:"participant_info,omitempty" json:"participant_info,omitempty"`
+	Status      string             `bson:"status,omitempty" json:"status,omitempty"`
+	Comment     string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	RespondedAt time.Time          `bson:"responded_at,omitempty" json:"responded_at,omitempty"`
+	Attended    *bool              `bson:"attended,omitempty" json:"attended,omitempty"`
+}
+
+// RSVPSummary info
+// @Description Meeting 참석 응답 및 출석 집계
+type RSVPSummary struct {
+	Pending   int `json:"pending"`
+	Accepted  int `json:"accepted"`
+	Declined  int `json:"declined"`
+	Tentative int `json:"tentative"`
+	Attended  int `json:"attended"`
+	Absent    int `json:"absent"`
+} //@name RSVPSummary
+
+// IsValidRSVPStatus 참석자가 응답할 수 있는 상태인지 확인
+func IsValidRSVPStatus(status string) bool {
+	switch status {
+	case RSVPAccepted, RSVPDeclined, RSVPTentative:
+		return true
+	}
+	return false
+}
+
+// SetRSVPSummary 참석자 목록으로 응답/출석 집계를 계산
+func (m *Meeting) SetRSVPSummary() {
+	summary := &RSVPSummary{}
+
+	for _, participant := range m.Participants {
+		if participant.isEmpty() {
+			continue
+		}
+
+		switch participant.Status {
+		case RSVPAccepted:
+			summary.Accepted++
+		case RSVPDeclined:
+			summary.Declined++
+		case RSVPTentative:
+			summary.Tentative++
+		default:
+			// 상태가 없는 기존 데이터는 미응답으로 취급
+			summary.Pending++
+		}
+
+		if participant.Attended != nil {
+			if *participant.Attended {
+				summary.Attended++
+			} else {
+				summary.Absent++
+			}
+		}
+	}
+
+	m.RSVPSummary = summary
 }
 
 // meetingUser info
@@ -75,12 +234,18 @@ func (p Participant) isEmpty() bool {
 func (p Participant) MarshalJSON() ([]byte, error) {
 	type Alias Participant
 	aux := &struct {
-		User *string `json:"participant,omitempty"`
+		User        *string    `json:"participant,omitempty"`
+		RespondedAt *time.Time `json:"responded_at,omitempty"`
 		*Alias
 	}{
 		Alias: (*Alias)(&p),
 	}
 
+	// RespondedAt 필드가 기본값이면 nil로 설정
+	if !p.RespondedAt.IsZero() {
+		aux.RespondedAt = &p.RespondedAt
+	}
+
 	// User 필드가 기본값이면 nil로 설정
 	if p.User.IsZero() {
 		aux.User = nil
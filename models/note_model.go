@@ -9,10 +9,13 @@ import (
 // Note info
 // @Description Note information
 type Note struct {
-	ID         primitive.ObjectID `bson:"_id" json:"id"`
-	Author     primitive.ObjectID `bson:"author" json:"author"`
-	AuthorInfo []User             `bson:"author_info,omitempty" json:"author_info,omitempty"`
-	Text       string             `bson:"text" json:"text"`
-	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+	ID         primitive.ObjectID  `bson:"_id" json:"id"`
+	Author     primitive.ObjectID  `bson:"author" json:"author"`
+	AuthorInfo []User              `bson:"author_info,omitempty" json:"author_info,omitempty"`
+	Text       string              `bson:"text" json:"text"`
+	Version    int64               `bson:"version" json:"version"`
+	DeletedAt  *time.Time          `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy  *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name Note
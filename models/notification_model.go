@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Notification 종류
+const (
+	NotificationMention = "mention"
+)
+
+// Notification info
+// @Description 유저 알림
+type Notification struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	User       primitive.ObjectID `bson:"user" json:"user"`
+	Type       string             `bson:"type" json:"type"`
+	Actor      primitive.ObjectID `bson:"actor" json:"actor"`
+	TargetType string             `bson:"target_type" json:"target_type"`
+	Target     primitive.ObjectID `bson:"target" json:"target"`
+	Comment    primitive.ObjectID `bson:"comment,omitempty" json:"comment,omitempty"`
+	Message    string             `bson:"message" json:"message"`
+	ReadAt     *time.Time         `bson:"read_at,omitempty" json:"read_at,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+} //@name Notification
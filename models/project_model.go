@@ -9,10 +9,102 @@ import (
 // Project info
 // @Description Project information
 type Project struct {
-	ID        primitive.ObjectID `bson:"_id" json:"id"`
-	Name      string             `bson:"name" json:"name"`
-	StartDt   time.Time          `bson:"start_dt" json:"start_dt"`
-	EndDt     time.Time          `bson:"end_dt" json:"end_dt"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+	ID         primitive.ObjectID  `bson:"_id" json:"id"`
+	Name       string              `bson:"name" json:"name"`
+	Owner      primitive.ObjectID  `bson:"owner,omitempty" json:"owner,omitempty"`
+	Members    []ProjectMember     `bson:"members,omitempty" json:"members,omitempty"`
+	Milestones []Milestone         `bson:"milestones,omitempty" json:"milestones,omitempty"`
+	StartDt    time.Time           `bson:"start_dt" json:"start_dt"`
+	EndDt      time.Time           `bson:"end_dt" json:"end_dt"`
+	ArchivedAt *time.Time          `bson:"archived_at,omitempty" json:"archived_at,omitempty"`
+	Version    int64               `bson:"version" json:"version"`
+	DeletedAt  *time.Time          `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy  *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name Project
+
+// Project 멤버 역할
+const (
+	ProjectRoleOwner      = "owner"
+	ProjectRoleMaintainer = "maintainer"
+	ProjectRoleViewer     = "viewer"
+)
+
+// ProjectMember info
+// @Description Project 멤버와 역할
+type ProjectMember struct {
+	User    primitive.ObjectID `bson:"user" json:"user"`
+	Role    string             `bson:"role" json:"role"`
+	AddedAt time.Time          `bson:"added_at" json:"added_at"`
+} //@name ProjectMember
+
+// Milestone info
+// @Description Project 마일스톤
+type Milestone struct {
+	ID    primitive.ObjectID   `bson:"_id" json:"id"`
+	Name  string               `bson:"name" json:"name"`
+	DueDt time.Time            `bson:"due_dt" json:"due_dt"`
+	Tasks []primitive.ObjectID `bson:"tasks" json:"tasks"`
+} //@name Milestone
+
+// MemberRole 유저의 Project 역할을 반환, 멤버가 아니면 빈 문자열
+func (p *Project) MemberRole(userId primitive.ObjectID) string {
+	for _, member := range p.Members {
+		if member.User == userId {
+			return member.Role
+		}
+	}
+	return ""
+}
+
+// IsArchived 보관된 Project인지 확인
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
+// CanRead Project와 하위 Task/Todo를 조회할 수 있는지 확인
+// 멤버가 없는 Project는 아무도 접근할 수 없으므로 MigrateProjectOwners로 소유자를 지정
+func (p *Project) CanRead(userId primitive.ObjectID) bool {
+	return p.MemberRole(userId) != ""
+}
+
+// CanWrite Project와 하위 Task/Todo를 수정할 수 있는지 확인 (owner, maintainer)
+func (p *Project) CanWrite(userId primitive.ObjectID) bool {
+	role := p.MemberRole(userId)
+	return role == ProjectRoleOwner || role == ProjectRoleMaintainer
+}
+
+// IsOwner Project 소유자인지 확인
+func (p *Project) IsOwner(userId primitive.ObjectID) bool {
+	return p.MemberRole(userId) == ProjectRoleOwner
+}
+
+// ProjectDetail info
+// @Description Project 상세 정보와 진행 현황 집계
+type ProjectDetail struct {
+	Project
+	Stats ProjectStats `json:"stats"`
+} //@name ProjectDetail
+
+// ProjectStats info
+// @Description Project 진행 현황 집계
+type ProjectStats struct {
+	TaskCount        int               `json:"task_count"`
+	TaskStatusCounts map[string]int    `json:"task_status_counts"`
+	TodoCount        int               `json:"todo_count"`
+	TodoStatusCounts map[string]int    `json:"todo_status_counts"`
+	PercentComplete  float64           `json:"percent_complete"`
+	OverdueCount     int               `json:"overdue_count"`
+	Workload         []ManagerWorkload `json:"workload"`
+	DaysRemaining    *int              `json:"days_remaining,omitempty"`
+} //@name ProjectStats
+
+// ManagerWorkload info
+// @Description 담당자별 Project Task 현황
+type ManagerWorkload struct {
+	Manager       primitive.ObjectID `bson:"_id" json:"manager"`
+	UserName      string             `bson:"user_name" json:"user_name"`
+	TaskCount     int                `bson:"task_count" json:"task_count"`
+	OpenTaskCount int                `bson:"open_task_count" json:"open_task_count"`
+} //@name ManagerWorkload
@@ -9,19 +9,36 @@ import (
 // ProjectTask info
 // @Description ProjectTask information
 type ProjectTask struct {
-	ID              primitive.ObjectID `bson:"_id" json:"id"`
-	Project         primitive.ObjectID `bson:"project" json:"project"`
-	ProjectrInfo    []Project          `bson:"project_info,omitempty" json:"project_info,omitempty"`
-	User            primitive.ObjectID `bson:"manager" json:"manager"`
-	UserInfo        []manager          `bson:"manager_info,omitempty" json:"manager_info,omitempty"`
-	Department      primitive.ObjectID `bson:"department,omitempty" json:"department,omitempty"`
-	DepartmentInfo  []Department       `bson:"department_info,omitempty" json:"department_info,omitempty"`
-	TaskDescription string             `bson:"task_description" json:"task_description"`
-	Status          string             `bson:"status" json:"status"`
-	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+	ID              primitive.ObjectID   `bson:"_id" json:"id"`
+	Project         primitive.ObjectID   `bson:"project" json:"project"`
+	ProjectrInfo    []Project            `bson:"project_info,omitempty" json:"project_info,omitempty"`
+	User            primitive.ObjectID   `bson:"manager" json:"manager"`
+	UserInfo        []manager            `bson:"manager_info,omitempty" json:"manager_info,omitempty"`
+	Department      primitive.ObjectID   `bson:"department,omitempty" json:"department,omitempty"`
+	DepartmentInfo  []Department         `bson:"department_info,omitempty" json:"department_info,omitempty"`
+	TaskDescription string               `bson:"task_description" json:"task_description"`
+	Status          string               `bson:"status" json:"status"`
+	StartDt         *time.Time           `bson:"start_dt,omitempty" json:"start_dt,omitempty"`
+	EndDt           *time.Time           `bson:"end_dt,omitempty" json:"end_dt,omitempty"`
+	BlockedBy       []primitive.ObjectID `bson:"blocked_by,omitempty" json:"blocked_by,omitempty"`
+	EstimateMinutes int64                `bson:"estimate_minutes,omitempty" json:"estimate_minutes,omitempty"`
+	CommentCount    int                  `bson:"comment_count,omitempty" json:"comment_count"`
+	Version         int64                `bson:"version" json:"version"`
+	DeletedAt       *time.Time           `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy       *primitive.ObjectID  `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	// Project와 함께 삭제된 경우 해당 Project ID, Project를 복원하면 함께 복원
+	DeletedWith *primitive.ObjectID `bson:"deleted_with,omitempty" json:"deleted_with,omitempty"`
+	CreatedAt   time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name ProjectTask
 
+// Project Task 상태
+const (
+	TaskStatusTodo       = "todo"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusDone       = "done"
+)
+
 // manager info
 // @Description manager information
 type manager struct {
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProjectTemplate info
+// @Description Project 템플릿, 일정은 원본 Project 시작일 기준 분 단위 오프셋으로 저장
+type ProjectTemplate struct {
+	ID         primitive.ObjectID  `bson:"_id" json:"id"`
+	Name       string              `bson:"name" json:"name"`
+	Source     primitive.ObjectID  `bson:"source" json:"source"`
+	EndOffset  *int64              `bson:"end_offset,omitempty" json:"end_offset,omitempty"`
+	Tasks      []TemplateTask      `bson:"tasks" json:"tasks"`
+	Milestones []TemplateMilestone `bson:"milestones" json:"milestones"`
+	CreatedBy  primitive.ObjectID  `bson:"created_by" json:"created_by"`
+	CreatedAt  time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time           `bson:"updated_at" json:"updated_at"`
+} //@name ProjectTemplate
+
+// TemplateTask info
+// @Description 템플릿 Task, blocked_by는 템플릿 tasks 배열의 인덱스
+type TemplateTask struct {
+	Manager         primitive.ObjectID `bson:"manager" json:"manager"`
+	Department      primitive.ObjectID `bson:"department,omitempty" json:"department,omitempty"`
+	TaskDescription string             `bson:"task_description" json:"task_description"`
+	StartOffset     *int64             `bson:"start_offset,omitempty" json:"start_offset,omitempty"`
+	EndOffset       *int64             `bson:"end_offset,omitempty" json:"end_offset,omitempty"`
+	BlockedBy       []int              `bson:"blocked_by,omitempty" json:"blocked_by,omitempty"`
+	EstimateMinutes int64              `bson:"estimate_minutes,omitempty" json:"estimate_minutes,omitempty"`
+} //@name TemplateTask
+
+// TemplateMilestone info
+// @Description 템플릿 마일스톤, tasks는 템플릿 tasks 배열의 인덱스
+type TemplateMilestone struct {
+	Name      string `bson:"name" json:"name"`
+	DueOffset int64  `bson:"due_offset" json:"due_offset"`
+	Tasks     []int  `bson:"tasks" json:"tasks"`
+} //@name TemplateMilestone
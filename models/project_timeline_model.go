@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Timeline 항목 종류
+const (
+	TimelineTypeProject   = "project"
+	TimelineTypeMilestone = "milestone"
+	TimelineTypeTask      = "task"
+)
+
+// ProjectTimeline info
+// @Description Gantt 차트용 Project 일정 (project, milestone, task 항목을 순서대로 나열)
+type ProjectTimeline struct {
+	Project primitive.ObjectID `json:"project"`
+	Items   []TimelineItem     `json:"items"`
+} //@name ProjectTimeline
+
+// TimelineItem info
+// @Description Gantt 차트 항목, milestone은 시작과 종료가 마감일로 같고 일정이 없는 task는 start/end가 비어 있음
+type TimelineItem struct {
+	ID     primitive.ObjectID  `json:"id"`
+	Type   string              `json:"type"`
+	Name   string              `json:"name"`
+	Start  *time.Time          `json:"start,omitempty"`
+	End    *time.Time          `json:"end,omitempty"`
+	Parent *primitive.ObjectID `json:"parent,omitempty"`
+	Status string              `json:"status,omitempty"`
+} //@name TimelineItem
+
+// ProjectCriticalPath info
+// @Description Project 임계 경로, 선행 관계를 따라 소요 시간 합이 가장 긴 Task 순서
+type ProjectCriticalPath struct {
+	Project    primitive.ObjectID `json:"project"`
+	TotalHours float64            `json:"total_hours"`
+	Tasks      []CriticalPathTask `json:"tasks"`
+} //@name ProjectCriticalPath
+
+// CriticalPathTask info
+// @Description 임계 경로 위의 Task, 시작/종료는 선행 관계만 고려한 가장 이른 시점(첫 Task 시작 기준 경과 시간)
+type CriticalPathTask struct {
+	ID            primitive.ObjectID `json:"id"`
+	Name          string             `json:"name"`
+	Status        string             `json:"status"`
+	DurationHours float64            `json:"duration_hours"`
+	StartHours    float64            `json:"start_hours"`
+	FinishHours   float64            `json:"finish_hours"`
+} //@name CriticalPathTask
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// RecruitingFunnel info
+// @Description 기간 내 JobApplication 채용 퍼널 집계 (start_dt 기준)
+type RecruitingFunnel struct {
+	From                  *time.Time        `json:"from,omitempty"`
+	To                    *time.Time        `json:"to,omitempty"`
+	Total                 int               `json:"total"`
+	Hired                 int               `json:"hired"`
+	Stages                []FunnelStage     `json:"stages"`
+	MedianTimeToHireHours *float64          `json:"median_time_to_hire_hours,omitempty"`
+	ByDepartment          []FunnelBreakdown `json:"by_department"`
+	ByPosition            []FunnelBreakdown `json:"by_position"`
+	ByLocation            []FunnelBreakdown `json:"by_location"`
+} //@name RecruitingFunnel
+
+// FunnelStage info
+// @Description 채용 단계별 현재 인원과 도달 인원, conversion_rate는 이전 단계 도달 인원 대비 비율(%)
+type FunnelStage struct {
+	Stage          string   `json:"stage"`
+	Current        int      `json:"current"`
+	Reached        int      `json:"reached"`
+	ConversionRate *float64 `json:"conversion_rate,omitempty"`
+} //@name FunnelStage
+
+// FunnelBreakdown info
+// @Description 부서/포지션/근무지별 지원 수와 채용 수
+type FunnelBreakdown struct {
+	Key   string `bson:"_id" json:"key"`
+	Name  string `bson:"name,omitempty" json:"name,omitempty"`
+	Total int    `bson:"total" json:"total"`
+	Hired int    `bson:"hired" json:"hired"`
+} //@name FunnelBreakdown
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RecruitmentPipeline info
+// @Description 부서별 채용 단계 정의
+type RecruitmentPipeline struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	Department primitive.ObjectID `bson:"department" json:"department"`
+	Name       string             `bson:"name" json:"name"`
+	Stages     []PipelineStage    `bson:"stages" json:"stages"`
+	Version    int64              `bson:"version" json:"version"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+} //@name RecruitmentPipeline
+
+// PipelineStage info
+// @Description 채용 단계, next가 비어 있으면 바로 다음 단계와 종료 단계로만 이동 가능
+type PipelineStage struct {
+	Key      string   `bson:"key" json:"key"`
+	Name     string   `bson:"name" json:"name"`
+	Terminal bool     `bson:"terminal,omitempty" json:"terminal,omitempty"`
+	Next     []string `bson:"next,omitempty" json:"next,omitempty"`
+} //@name PipelineStage
+
+// StageIndex 단계의 순서를 반환, 없으면 -1
+func (p *RecruitmentPipeline) StageIndex(key string) int {
+	for i, stage := range p.Stages {
+		if stage.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// CanMove from 단계에서 to 단계로 이동할 수 있는지 확인
+// 종료 단계에서는 이동할 수 없고, next가 정의되지 않은 단계는 바로 다음 단계와 종료 단계로만 이동 가능
+func (p *RecruitmentPipeline) CanMove(from string, to string) bool {
+	fromIndex, toIndex := p.StageIndex(from), p.StageIndex(to)
+	if toIndex < 0 {
+		return false
+	}
+
+	// 기존 자유 입력 단계에서는 어느 단계로든 옮겨 파이프라인에 편입
+	if fromIndex < 0 {
+		return true
+	}
+
+	stage := p.Stages[fromIndex]
+	if stage.Terminal {
+		return false
+	}
+
+	if len(stage.Next) > 0 {
+		for _, next := range stage.Next {
+			if next == to {
+				return true
+			}
+		}
+		return false
+	}
+
+	return toIndex == fromIndex+1 || p.Stages[toIndex].Terminal
+}
+
+// StageMetric info
+// @Description 채용 단계별 현재 지원자 수와 평균 체류 시간
+type StageMetric struct {
+	Stage        string  `json:"stage"`
+	Name         string  `json:"name"`
+	Current      int     `json:"current"`
+	Entered      int     `json:"entered"`
+	AverageHours float64 `json:"average_hours"`
+} //@name StageMetric
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 채용 결정이 없는 JobApplication에 적용하는 보존 정책 구분
+const RetentionOutcomeUndecided = "undecided"
+
+// IsValidRetentionOutcome 보존 정책을 둘 수 있는 지원 결과인지 확인
+func IsValidRetentionOutcome(outcome string) bool {
+	return outcome == RetentionOutcomeUndecided || IsValidHiringDecision(outcome)
+}
+
+// RetentionPolicy info
+// @Description 지원 결과별 개인정보 보존 기간, 채용 결정 일시(결정이 없으면 마지막 수정 일시)로부터 retention_days가 지나면 익명화
+type RetentionPolicy struct {
+	ID            primitive.ObjectID `bson:"_id" json:"id"`
+	Outcome       string             `bson:"outcome" json:"outcome"`
+	RetentionDays int                `bson:"retention_days" json:"retention_days"`
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+} //@name RetentionPolicy
+
+// 개인정보 삭제 사유
+const (
+	ErasureReasonRetention = "retention"
+	ErasureReasonManual    = "manual"
+)
+
+// ErasureLog info
+// @Description 개인정보 익명화/삭제 기록, interviews는 제목/설명에서 지원자 이름을 지운 면접 Meeting 수
+type ErasureLog struct {
+	ID             primitive.ObjectID  `bson:"_id" json:"id"`
+	JobApplication *primitive.ObjectID `bson:"job_application,omitempty" json:"job_application,omitempty"`
+	Candidate      *primitive.ObjectID `bson:"candidate,omitempty" json:"candidate,omitempty"`
+	Reason         string              `bson:"reason" json:"reason"`
+	Policy         *primitive.ObjectID `bson:"policy,omitempty" json:"policy,omitempty"`
+	Fields         []string            `bson:"fields" json:"fields"`
+	Attachments    int                 `bson:"attachments" json:"attachments"`
+	Interviews     int                 `bson:"interviews" json:"interviews"`
+	ErasedBy       *primitive.ObjectID `bson:"erased_by,omitempty" json:"erased_by,omitempty"`
+	ErasedAt       time.Time           `bson:"erased_at" json:"erased_at"`
+} //@name ErasureLog
+
+// RetentionRunResult info
+// @Description 보존 정책 실행 결과
+type RetentionRunResult struct {
+	Anonymized int `json:"anonymized"`
+	Candidates int `json:"candidates"`
+} //@name RetentionRunResult
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ScorecardTemplate info
+// @Description 포지션별 면접 평가표 템플릿
+type ScorecardTemplate struct {
+	ID        primitive.ObjectID   `bson:"_id" json:"id"`
+	Position  string               `bson:"position" json:"position"`
+	Name      string               `bson:"name" json:"name"`
+	Criteria  []ScorecardCriterion `bson:"criteria" json:"criteria"`
+	Version   int64                `bson:"version" json:"version"`
+	CreatedAt time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time            `bson:"updated_at" json:"updated_at"`
+} //@name ScorecardTemplate
+
+// ScorecardCriterion info
+// @Description 평가 항목과 점수 범위, weight는 종합 점수 계산 가중치
+type ScorecardCriterion struct {
+	Key         string  `bson:"key" json:"key"`
+	Name        string  `bson:"name" json:"name"`
+	Description string  `bson:"description,omitempty" json:"description,omitempty"`
+	ScaleMin    int     `bson:"scale_min" json:"scale_min"`
+	ScaleMax    int     `bson:"scale_max" json:"scale_max"`
+	Weight      float64 `bson:"weight" json:"weight"`
+} //@name ScorecardCriterion
+
+// 면접관 추천 의견
+const (
+	RecommendationStrongYes = "strong_yes"
+	RecommendationYes       = "yes"
+	RecommendationNo        = "no"
+	RecommendationStrongNo  = "strong_no"
+	// 추천 의견이 엇갈려 종합 의견을 정할 수 없는 경우
+	RecommendationMixed = "mixed"
+)
+
+// recommendationWeights 종합 추천 의견 계산에 쓰는 점수
+var recommendationWeights = map[string]float64{
+	RecommendationStrongYes: 2,
+	RecommendationYes:       1,
+	RecommendationNo:        -1,
+	RecommendationStrongNo:  -2,
+}
+
+// IsValidRecommendation 면접관이 제출할 수 있는 추천 의견인지 확인
+func IsValidRecommendation(recommendation string) bool {
+	_, ok := recommendationWeights[recommendation]
+	return ok
+}
+
+// Scorecard info
+// @Description 면접관이 JobApplication에 제출한 평가표, score는 0~100으로 환산한 가중 평균
+type Scorecard struct {
+	ID             primitive.ObjectID `bson:"_id" json:"id"`
+	JobApplication primitive.ObjectID `bson:"job_application" json:"job_application"`
+	Interviewer    primitive.ObjectID `bson:"interviewer" json:"interviewer"`
+	Template       primitive.ObjectID `bson:"template" json:"template"`
+	Ratings        []ScorecardRating  `bson:"ratings" json:"ratings"`
+	Score          float64            `bson:"score" json:"score"`
+	Recommendation string             `bson:"recommendation" json:"recommendation"`
+	Notes          string             `bson:"notes,omitempty" json:"notes,omitempty"`
+	SubmittedAt    time.Time          `bson:"submitted_at" json:"submitted_at"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+} //@name Scorecard
+
+// ScorecardRating info
+// @Description 평가 항목별 점수
+type ScorecardRating struct {
+	Criterion string `bson:"criterion" json:"criterion"`
+	Name      string `bson:"name" json:"name"`
+	Score     int    `bson:"score" json:"score"`
+	Comment   string `bson:"comment,omitempty" json:"comment,omitempty"`
+} //@name ScorecardRating
+
+// ScorecardSummary info
+// @Description JobApplication 평가표 종합 점수와 추천 의견 집계
+type ScorecardSummary struct {
+	JobApplication  primitive.ObjectID `json:"job_application"`
+	SubmittedCount  int                `json:"submitted_count"`
+	AverageScore    float64            `json:"average_score"`
+	Criteria        []CriterionAverage `json:"criteria"`
+	Recommendations map[string]int     `json:"recommendations"`
+	Recommendation  string             `json:"recommendation,omitempty"`
+	Decision        *HiringDecision    `json:"decision,omitempty"`
+} //@name ScorecardSummary
+
+// CriterionAverage info
+// @Description 평가 항목별 평균 점수
+type CriterionAverage struct {
+	Criterion string  `json:"criterion"`
+	Name      string  `json:"name"`
+	Average   float64 `json:"average"`
+	Count     int     `json:"count"`
+} //@name CriterionAverage
+
+// OverallRecommendation 추천 의견 개수로 종합 의견을 계산, 제출된 평가표가 없으면 빈 문자열
+func OverallRecommendation(recommendations map[string]int) string {
+	total, count := 0.0, 0
+	for recommendation, n := range recommendations {
+		total += recommendationWeights[recommendation] * float64(n)
+		count += n
+	}
+
+	if count == 0 {
+		return ""
+	}
+
+	switch average := total / float64(count); {
+	case average >= 1.5:
+		return RecommendationStrongYes
+	case average >= 0.5:
+		return RecommendationYes
+	case average <= -1.5:
+		return RecommendationStrongNo
+	case average <= -0.5:
+		return RecommendationNo
+	}
+	return RecommendationMixed
+}
+
+// 채용 결정
+const (
+	HiringDecisionHire   = "hire"
+	HiringDecisionReject = "reject"
+	HiringDecisionHold   = "hold"
+)
+
+// IsValidHiringDecision 기록할 수 있는 채용 결정인지 확인
+func IsValidHiringDecision(decision string) bool {
+	switch decision {
+	case HiringDecisionHire, HiringDecisionReject, HiringDecisionHold:
+		return true
+	}
+	return false
+}
+
+// HiringDecision info
+// @Description JobApplication 채용 결정
+type HiringDecision struct {
+	Decision  string             `bson:"decision" json:"decision"`
+	Note      string             `bson:"note,omitempty" json:"note,omitempty"`
+	DecidedBy primitive.ObjectID `bson:"decided_by" json:"decided_by"`
+	DecidedAt time.Time          `bson:"decided_at" json:"decided_at"`
+} //@name HiringDecision
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TimeEntry info
+// @Description 작업 시간 기록, Project Task 또는 Todo 중 하나에 연결되며 end_dt가 없으면 실행 중인 타이머
+type TimeEntry struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	User        primitive.ObjectID `bson:"user" json:"user"`
+	Project     primitive.ObjectID `bson:"project,omitempty" json:"project,omitempty"`
+	Task        primitive.ObjectID `bson:"task,omitempty" json:"task,omitempty"`
+	Todo        primitive.ObjectID `bson:"todo,omitempty" json:"todo,omitempty"`
+	Description string             `bson:"description,omitempty" json:"description,omitempty"`
+	StartDt     time.Time          `bson:"start_dt" json:"start_dt"`
+	EndDt       *time.Time         `bson:"end_dt,omitempty" json:"end_dt,omitempty"`
+	Minutes     int64              `bson:"minutes" json:"minutes"`
+	Running     bool               `bson:"running" json:"running"`
+	Version     int64              `bson:"version" json:"version"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+} //@name TimeEntry
+
+// TimeReportRow info
+// @Description 기간 내 작업 시간 집계 (Project, 유저 또는 부서별)
+type TimeReportRow struct {
+	ID      primitive.ObjectID `bson:"_id" json:"id"`
+	Name    string             `bson:"name" json:"name"`
+	Entries int                `bson:"entries" json:"entries"`
+	Minutes int64              `bson:"minutes" json:"minutes"`
+	Hours   float64            `bson:"-" json:"hours"`
+} //@name TimeReportRow
+
+// 예상/실제 시간 비교 대상 종류
+const (
+	TimeEstimateTypeTask = "task"
+	TimeEstimateTypeTodo = "todo"
+)
+
+// TimeEstimate info
+// @Description Project Task/Todo의 예상 시간과 실제 기록 시간 비교
+type TimeEstimate struct {
+	ID              primitive.ObjectID `json:"id"`
+	Type            string             `json:"type"`
+	Name            string             `json:"name"`
+	Status          string             `json:"status"`
+	EstimateMinutes int64              `json:"estimate_minutes"`
+	ActualMinutes   int64              `json:"actual_minutes"`
+	VarianceMinutes int64              `json:"variance_minutes"`
+} //@name TimeEstimate
@@ -9,17 +9,40 @@ import (
 // Todo info
 // @Description Todo information
 type Todo struct {
-	ID             primitive.ObjectID `bson:"_id" json:"id"`
-	Task           string             `bson:"task" json:"task"`
-	Status         string             `bson:"status" json:"status"`
-	Project        primitive.ObjectID `bson:"project,omitempty" json:"project,omitempty"`
-	ProjectrInfo   []Project          `bson:"project_info,omitempty" json:"project_info,omitempty"`
-	StartDt        time.Time          `bson:"start_dt" json:"start_dt"`
-	EndDt          time.Time          `bson:"end_dt" json:"end_dt"`
-	User           primitive.ObjectID `bson:"user" json:"user"`
-	UserInfo       []User             `bson:"user_info,omitempty" json:"user_info,omitempty"`
-	Department     primitive.ObjectID `bson:"department,omitempty" json:"department,omitempty"`
-	DepartmentInfo []Department       `bson:"department_info,omitempty" json:"department_info,omitempty"`
-	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+	ID              primitive.ObjectID  `bson:"_id" json:"id"`
+	Task            string              `bson:"task" json:"task"`
+	Status          string              `bson:"status" json:"status"`
+	Project         primitive.ObjectID  `bson:"project,omitempty" json:"project,omitempty"`
+	ProjectrInfo    []Project           `bson:"project_info,omitempty" json:"project_info,omitempty"`
+	StartDt         time.Time           `bson:"start_dt" json:"start_dt"`
+	EndDt           time.Time           `bson:"end_dt" json:"end_dt"`
+	User            primitive.ObjectID  `bson:"user" json:"user"`
+	UserInfo        []User              `bson:"user_info,omitempty" json:"user_info,omitempty"`
+	Department      primitive.ObjectID  `bson:"department,omitempty" json:"department,omitempty"`
+	DepartmentInfo  []Department        `bson:"department_info,omitempty" json:"department_info,omitempty"`
+	Meeting         primitive.ObjectID  `bson:"meeting,omitempty" json:"meeting,omitempty"`
+	MeetingInfo     []todoMeeting       `bson:"meeting_info,omitempty" json:"meeting_info,omitempty"`
+	EstimateMinutes int64               `bson:"estimate_minutes,omitempty" json:"estimate_minutes,omitempty"`
+	CommentCount    int                 `bson:"comment_count,omitempty" json:"comment_count"`
+	Version         int64               `bson:"version" json:"version"`
+	DeletedAt       *time.Time          `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy       *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	// Project와 함께 삭제된 경우 해당 Project ID, Project를 복원하면 함께 복원
+	DeletedWith *primitive.ObjectID `bson:"deleted_with,omitempty" json:"deleted_with,omitempty"`
+	CreatedAt   time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time           `bson:"updated_at" json:"updated_at"`
 } //@name Todo
+
+// Todo 상태
+const (
+	TodoStatusTodo = "todo"
+	TodoStatusDone = "done"
+)
+
+// todoMeeting info
+// @Description Todo가 생성된 Meeting 정보
+type todoMeeting struct {
+	ID      primitive.ObjectID `bson:"_id" json:"id"`
+	Title   string             `bson:"title" json:"title"`
+	StartDt time.Time          `bson:"start_dt" json:"start_dt"`
+} //@name todoMeeting
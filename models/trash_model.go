@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IsTrashResource 휴지통을 지원하는 리소스인지 확인
+func IsTrashResource(resource string) bool {
+	switch resource {
+	case AuditResourceNote, AuditResourceTodo, AuditResourceProject, AuditResourceProjectTask, AuditResourceMeeting, AuditResourceJobApplication:
+		return true
+	}
+	return false
+}
+
+// TrashItem info
+// @Description 휴지통 항목, purge_at이 지나면 영구 삭제
+type TrashItem struct {
+	Resource  string              `bson:"resource" json:"resource"`
+	ID        primitive.ObjectID  `bson:"_id" json:"id"`
+	Title     string              `bson:"title" json:"title"`
+	DeletedAt time.Time           `bson:"deleted_at" json:"deleted_at"`
+	DeletedBy *primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+	PurgeAt   time.Time           `bson:"-" json:"purge_at"`
+} //@name TrashItem
+
+// TrashPurgeResult info
+// @Description 휴지통 영구 삭제 결과, 리소스별 삭제 개수
+type TrashPurgeResult struct {
+	Purged map[string]int `json:"purged"`
+} //@name TrashPurgeResult
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// UserTOTP info
+// @Description 유저 TOTP 2단계 인증 설정, 비밀키와 복구 코드 해시는 응답에 포함하지 않음
+type UserTOTP struct {
+	Secret        string     `bson:"secret" json:"-"`
+	Enabled       bool       `bson:"enabled" json:"enabled"`
+	ConfirmedAt   *time.Time `bson:"confirmed_at,omitempty" json:"confirmed_at,omitempty"`
+	RecoveryCodes []string   `bson:"recovery_codes,omitempty" json:"-"`
+	LastStep      int64      `bson:"last_step,omitempty" json:"-"`
+} //@name UserTOTP
+
+// 로그인 2단계 상태
+const (
+	MFAStatusRequired           = "mfa_required"
+	MFAStatusEnrollmentRequired = "mfa_enrollment_required"
+)
+
+// TOTPEnrollment info
+// @Description 인증 앱 등록 정보, otpauth_url을 QR 코드로 표시
+type TOTPEnrollment struct {
+	Secret     string `json:"secret"`
+	OtpauthURL string `json:"otpauth_url"`
+} //@name TOTPEnrollment
+
+// MFAChallenge info
+// @Description 로그인 2단계 요청, mfa_token과 인증 코드로 /auth/2fa/verify 호출
+type MFAChallenge struct {
+	Status     string          `json:"status"`
+	MFAToken   string          `json:"mfa_token"`
+	Enrollment *TOTPEnrollment `json:"enrollment,omitempty"`
+} //@name MFAChallenge
+
+// RecoveryCodes info
+// @Description 복구 코드, 발급 시 한 번만 보여주고 해시만 저장
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+} //@name RecoveryCodes
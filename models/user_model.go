@@ -19,6 +19,67 @@ type User struct {
 	Photo          string             `bson:"photo" json:"photo"`
 	Department     primitive.ObjectID `bson:"department,omitempty" json:"department,omitempty"`
 	DepartmentInfo []Department       `bson:"department_info,omitempty" json:"department_info,omitempty"`
+	Role           string             `bson:"role,omitempty" json:"role,omitempty"`
+	DeactivatedAt  *time.Time         `bson:"deactivated_at,omitempty" json:"deactivated_at,omitempty"`
+	PasswordHash   string             `bson:"password_hash,omitempty" json:"-"`
+	FailedLogins   int                `bson:"failed_logins,omitempty" json:"-"`
+	LockedUntil    *time.Time         `bson:"locked_until,omitempty" json:"locked_until,omitempty"`
+	TOTP           *UserTOTP          `bson:"totp,omitempty" json:"totp,omitempty"`
+	Version        int64              `bson:"version" json:"version"`
 	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
 } //@name User
+
+// 유저 권한, 값이 없으면 일반 유저
+const (
+	UserRoleAdmin  = "admin"
+	UserRoleMember = "member"
+)
+
+// RoleName 유저 권한, 값이 없으면 일반 유저
+func (u *User) RoleName() string {
+	if u.Role == "" {
+		return UserRoleMember
+	}
+	return u.Role
+}
+
+// HasTOTP 2단계 인증을 등록한 유저인지 확인
+func (u *User) HasTOTP() bool {
+	return u.TOTP != nil && u.TOTP.Enabled
+}
+
+// IsAdmin 관리자인지 확인
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
+// 로그인 방식
+const (
+	UserProviderGoogle = "google"
+	UserProviderLocal  = "local"
+)
+
+// IsLocked 로그인 실패가 반복되어 잠긴 상태인지 확인
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && u.LockedUntil.After(now)
+}
+
+// IsVerified 이메일 인증이 끝난 유저인지 확인
+func (u *User) IsVerified() bool {
+	return u.Verified != nil && *u.Verified
+}
+
+// IsActive 비활성화되지 않은 유저인지 확인
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
+}
+
+// UserSummary info
+// @Description 참석자/담당자 선택용 유저 요약 정보
+type UserSummary struct {
+	ID       primitive.ObjectID `bson:"_id" json:"id"`
+	UserName string             `bson:"user_name" json:"user_name"`
+	Photo    string             `bson:"photo" json:"photo"`
+	Position string             `bson:"position,omitempty" json:"position,omitempty"`
+} //@name UserSummary
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AuditRoutes struct {
+	auditHandler handlers.AuditHandler
+}
+
+func NewAuditRoutes(auditHandler handlers.AuditHandler) AuditRoutes {
+	return AuditRoutes{auditHandler}
+}
+
+func (ar *AuditRoutes) SetAuditRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	// 조회만 제공, 감사 로그를 수정/삭제하는 API는 없음
+	audits := router.Group("/audit-logs", middleware.DeserializeUser(collection), middleware.RequireAdmin())
+
+	audits.GET("/", ar.auditHandler.GetAuditLogs)
+	audits.GET("/verify", ar.auditHandler.VerifyAuditLogs)
+}
@@ -22,4 +22,20 @@ func (ar *AuthRoutes) SetAuthRoutes(router *gin.RouterGroup, collection *mongo.C
 	auths.GET("/logout", ar.authHandler.LogoutUser)
 	auths.GET("/users", middleware.DeserializeUser(collection), ar.authHandler.GetMe)
 	auths.GET("/refresh", ar.authHandler.RefreshAccessToken)
+
+	// 이메일/비밀번호 로그인
+	auths.POST("/register", ar.authHandler.Register)
+	auths.POST("/verify-email", ar.authHandler.VerifyEmail)
+	auths.POST("/login", ar.authHandler.Login)
+	auths.POST("/password/forgot", ar.authHandler.ForgotPassword)
+	auths.POST("/password/reset", ar.authHandler.ResetPassword)
+
+	// TOTP 2단계 인증, challenge/verify는 로그인 중 받은 mfa_token으로 호출
+	twoFactor := auths.Group("/2fa")
+	twoFactor.GET("/challenge", ar.authHandler.GetMFAChallenge)
+	twoFactor.POST("/verify", ar.authHandler.VerifyMFA)
+	twoFactor.POST("/enroll", middleware.DeserializeUser(collection), ar.authHandler.EnrollTOTP)
+	twoFactor.POST("/confirm", middleware.DeserializeUser(collection), ar.authHandler.ConfirmTOTP)
+	twoFactor.POST("/recovery-codes", middleware.DeserializeUser(collection), ar.authHandler.RegenerateRecoveryCodes)
+	twoFactor.POST("/disable", middleware.DeserializeUser(collection), ar.authHandler.DisableTOTP)
 }
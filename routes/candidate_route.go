@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CandidateRoutes struct {
+	candidateHandler handlers.CandidateHandler
+}
+
+func NewCandidateRoutes(candidateHandler handlers.CandidateHandler) CandidateRoutes {
+	return CandidateRoutes{candidateHandler}
+}
+
+func (cr *CandidateRoutes) SetCandidateRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	candidates := router.Group("/candidates", middleware.DeserializeUser(collection))
+
+	candidates.GET("/", cr.candidateHandler.GetAllCandidate)
+	candidates.GET("/:id", cr.candidateHandler.GetCandidateProfile)
+	candidates.GET("/:id/duplicates", cr.candidateHandler.GetCandidateDuplicates)
+	candidates.POST("/", cr.candidateHandler.CreateCandidate)
+	candidates.PATCH("/:id", cr.candidateHandler.UpdateCandidate)
+	candidates.POST("/:id/merge", cr.candidateHandler.MergeCandidate)
+	candidates.DELETE("/:id", cr.candidateHandler.DeleteCandidate)
+
+}
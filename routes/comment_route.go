@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CommentRoutes struct {
+	commentHandler handlers.CommentHandler
+}
+
+func NewCommentRoutes(commentHandler handlers.CommentHandler) CommentRoutes {
+	return CommentRoutes{commentHandler}
+}
+
+func (cr *CommentRoutes) SetCommentRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	comments := router.Group("/comments", middleware.DeserializeUser(collection))
+
+	comments.GET("/", cr.commentHandler.GetCommentByTarget)
+	comments.POST("/", cr.commentHandler.CreateComment)
+	comments.PATCH("/:id", cr.commentHandler.UpdateComment)
+	comments.DELETE("/:id", cr.commentHandler.DeleteComment)
+
+}
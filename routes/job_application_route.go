@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type JobApplicationRoutes struct {
@@ -13,14 +15,19 @@ func NewJobApplicationRoutes(jobApplication handlers.JobApplicationHandler) JobA
 	return JobApplicationRoutes{jobApplication}
 }
 
-func (jr *JobApplicationRoutes) SetJobApplicationRoutes(router *gin.RouterGroup) {
+func (jr *JobApplicationRoutes) SetJobApplicationRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
 	jobApplications := router.Group("/jobApplications")
 
 	jobApplications.GET("/", jr.jobApplicationHandler.GetAllJobApplication)
+	jobApplications.GET("/funnel", jr.jobApplicationHandler.GetRecruitingFunnel)
 	jobApplications.GET("/:id", jr.jobApplicationHandler.GetJobApplication)
+	jobApplications.GET("/:id/stage-durations", jr.jobApplicationHandler.GetJobApplicationStageDurations)
 	jobApplications.GET("/manager/:id", jr.jobApplicationHandler.GetJobApplicationByManager)
-	jobApplications.POST("/", jr.jobApplicationHandler.CreateJobApplication)
-	jobApplications.PATCH("/:id", jr.jobApplicationHandler.UpdateJobApplication)
-	jobApplications.DELETE("/:id", jr.jobApplicationHandler.DeleteJobApplication)
+	jobApplications.POST("/", middleware.DeserializeUser(collection), jr.jobApplicationHandler.CreateJobApplication)
+	jobApplications.PATCH("/:id", middleware.DeserializeUser(collection), jr.jobApplicationHandler.UpdateJobApplication)
+	jobApplications.DELETE("/:id", middleware.DeserializeUser(collection), jr.jobApplicationHandler.DeleteJobApplication)
+	jobApplications.GET("/:id/interviews", jr.jobApplicationHandler.GetJobApplicationInterviews)
+	jobApplications.POST("/:id/interviews", middleware.DeserializeUser(collection), jr.jobApplicationHandler.ScheduleInterview)
+	jobApplications.PATCH("/:id/interviews/:meetingId/outcome", middleware.DeserializeUser(collection), jr.jobApplicationHandler.UpdateInterviewOutcome)
 
 }
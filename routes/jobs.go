@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/jobs"
+)
+
+// StartJobs 주기 작업을 시작, SetDependency 이후에 호출
+func StartJobs(ctx context.Context) {
+	// 보존 기간이 지난 지원자 개인정보 익명화
+	jobs.Every(ctx, "retention", jobs.Interval("RETENTION_JOB_INTERVAL", 24*time.Hour), func() error {
+		result, err := retentionService.ApplyRetentionPolicies(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("retention: anonymized %d job applications, erased %d candidates", result.Anonymized, result.Candidates)
+		return nil
+	})
+
+	// 보관 기간(TRASH_RETENTION_DAYS)이 지난 휴지통 항목과 항목에 달린 댓글, 알림, 시간 기록을 영구 삭제
+	jobs.Every(ctx, "trash-purge", jobs.Interval("TRASH_PURGE_INTERVAL", 24*time.Hour), func() error {
+		result, err := trashService.PurgeTrash()
+		if err != nil {
+			return err
+		}
+		log.Printf("trash-purge: purged %v", result.Purged)
+		return nil
+	})
+}
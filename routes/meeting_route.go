@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type MeetingRoutes struct {
@@ -13,13 +15,19 @@ func NewMeetingRoutes(meetingHandler handlers.MeetingHandler) MeetingRoutes {
 	return MeetingRoutes{meetingHandler}
 }
 
-func (mr *MeetingRoutes) SetMeetingRoutes(router *gin.RouterGroup) {
+func (mr *MeetingRoutes) SetMeetingRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
 	meetings := router.Group("/meetings")
 
 	meetings.GET("/", mr.meetingHandler.GetAllMeeting)
+	meetings.GET("/calendar", mr.meetingHandler.GetMeetingCalendar)
 	meetings.GET("/:id", mr.meetingHandler.GetMeeting)
 	meetings.GET("/created-by/:id", mr.meetingHandler.GetMeetingByUser)
-	meetings.POST("/", mr.meetingHandler.CreateMeeting)
-	meetings.PATCH("/:id", mr.meetingHandler.UpdateMeeting)
-	meetings.DELETE("/:id", mr.meetingHandler.DeleteMeeting)
+	meetings.POST("/", middleware.DeserializeUser(collection), mr.meetingHandler.CreateMeeting)
+	meetings.PATCH("/:id", middleware.DeserializeUser(collection), mr.meetingHandler.UpdateMeeting)
+	meetings.DELETE("/:id", middleware.DeserializeUser(collection), mr.meetingHandler.DeleteMeeting)
+	meetings.PATCH("/:id/rsvp", middleware.DeserializeUser(collection), mr.meetingHandler.RespondMeeting)
+	meetings.PATCH("/:id/attendance", middleware.DeserializeUser(collection), mr.meetingHandler.MarkAttendance)
+	meetings.PATCH("/:id/occurrences", middleware.DeserializeUser(collection), mr.meetingHandler.UpdateMeetingOccurrence)
+	meetings.PUT("/:id/minutes", middleware.DeserializeUser(collection), mr.meetingHandler.UpdateMeetingMinutes)
+	meetings.POST("/:id/minutes/todos", middleware.DeserializeUser(collection), mr.meetingHandler.ConvertActionItemsToTodos)
 }
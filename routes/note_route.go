@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type NoteRoutes struct {
@@ -13,14 +15,14 @@ func NewNoteRoutes(noteHandler handlers.NoteHandler) NoteRoutes {
 	return NoteRoutes{noteHandler}
 }
 
-func (nr *NoteRoutes) SetNoteRoutes(router *gin.RouterGroup) {
+func (nr *NoteRoutes) SetNoteRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
 	notes := router.Group("/notes")
 
 	notes.GET("/", nr.noteHandler.GetAllNote)
 	notes.GET("/:id", nr.noteHandler.GetNote)
 	notes.GET("/user/:id", nr.noteHandler.GetNoteByUser)
-	notes.POST("/", nr.noteHandler.CreateNote)
-	notes.PATCH("/:id", nr.noteHandler.UpdateNote)
-	notes.DELETE("/:id", nr.noteHandler.DeleteNote)
+	notes.POST("/", middleware.DeserializeUser(collection), nr.noteHandler.CreateNote)
+	notes.PATCH("/:id", middleware.DeserializeUser(collection), nr.noteHandler.UpdateNote)
+	notes.DELETE("/:id", middleware.DeserializeUser(collection), nr.noteHandler.DeleteNote)
 
 }
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type NotificationRoutes struct {
+	notificationHandler handlers.NotificationHandler
+}
+
+func NewNotificationRoutes(notificationHandler handlers.NotificationHandler) NotificationRoutes {
+	return NotificationRoutes{notificationHandler}
+}
+
+func (nr *NotificationRoutes) SetNotificationRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	notifications := router.Group("/notifications", middleware.DeserializeUser(collection))
+
+	notifications.GET("/", nr.notificationHandler.GetMyNotification)
+	notifications.PATCH("/read", nr.notificationHandler.ReadAllNotification)
+	notifications.PATCH("/:id/read", nr.notificationHandler.ReadNotification)
+
+}
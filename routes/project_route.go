@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type ProjectRoutes struct {
@@ -13,12 +15,22 @@ func NewProjectRoutes(projectHandler handlers.ProjectHandler) ProjectRoutes {
 	return ProjectRoutes{projectHandler}
 }
 
-func (pr *ProjectRoutes) SetProjectRoutes(router *gin.RouterGroup) {
-	projects := router.Group("/projects")
+func (pr *ProjectRoutes) SetProjectRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	projects := router.Group("/projects", middleware.DeserializeUser(collection))
 
 	projects.GET("/", pr.projectHandler.GetAllProject)
+	projects.GET("/:id", pr.projectHandler.GetProject)
 	projects.POST("/", pr.projectHandler.CreateProject)
 	projects.PATCH("/:id", pr.projectHandler.UpdateProject)
 	projects.DELETE("/:id", pr.projectHandler.DeleteProject)
+	projects.PATCH("/:id/archive", pr.projectHandler.ArchiveProject)
+	projects.PATCH("/:id/unarchive", pr.projectHandler.UnarchiveProject)
+	projects.POST("/:id/members", pr.projectHandler.AddProjectMember)
+	projects.DELETE("/:id/members/:userId", pr.projectHandler.RemoveProjectMember)
+	projects.POST("/:id/milestones", pr.projectHandler.AddProjectMilestone)
+	projects.PATCH("/:id/milestones/:milestoneId", pr.projectHandler.UpdateProjectMilestone)
+	projects.DELETE("/:id/milestones/:milestoneId", pr.projectHandler.DeleteProjectMilestone)
+	projects.GET("/:id/timeline", pr.projectHandler.GetProjectTimeline)
+	projects.GET("/:id/critical-path", pr.projectHandler.GetProjectCriticalPath)
 
 }
@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type ProjectTaskRoutes struct {
@@ -13,8 +15,8 @@ func NewProjectTaskRoutes(projectTaskHandler handlers.ProjectTaskHandler) Projec
 	return ProjectTaskRoutes{projectTaskHandler}
 }
 
-func (ptr *ProjectTaskRoutes) SetProjectTaskRoutes(router *gin.RouterGroup) {
-	tasks := router.Group("/project-tasks")
+func (ptr *ProjectTaskRoutes) SetProjectTaskRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	tasks := router.Group("/project-tasks", middleware.DeserializeUser(collection))
 
 	tasks.GET("/:id", ptr.projectTaskHandler.GetProjectTask)
 	tasks.GET("/project/:id", ptr.projectTaskHandler.GetProjectTaskByProject)
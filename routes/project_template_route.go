@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ProjectTemplateRoutes struct {
+	projectTemplateHandler handlers.ProjectTemplateHandler
+}
+
+func NewProjectTemplateRoutes(projectTemplateHandler handlers.ProjectTemplateHandler) ProjectTemplateRoutes {
+	return ProjectTemplateRoutes{projectTemplateHandler}
+}
+
+func (ptr *ProjectTemplateRoutes) SetProjectTemplateRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	templates := router.Group("/project-templates", middleware.DeserializeUser(collection))
+
+	templates.GET("/", ptr.projectTemplateHandler.GetAllProjectTemplate)
+	templates.GET("/:id", ptr.projectTemplateHandler.GetProjectTemplate)
+	templates.POST("/", ptr.projectTemplateHandler.CreateProjectTemplate)
+	templates.POST("/:id/instantiate", ptr.projectTemplateHandler.InstantiateProjectTemplate)
+	templates.DELETE("/:id", ptr.projectTemplateHandler.DeleteProjectTemplate)
+
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RecruitmentPipelineRoutes struct {
+	recruitmentPipelineHandler handlers.RecruitmentPipelineHandler
+}
+
+func NewRecruitmentPipelineRoutes(recruitmentPipelineHandler handlers.RecruitmentPipelineHandler) RecruitmentPipelineRoutes {
+	return RecruitmentPipelineRoutes{recruitmentPipelineHandler}
+}
+
+func (rpr *RecruitmentPipelineRoutes) SetRecruitmentPipelineRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	pipelines := router.Group("/recruitment-pipelines", middleware.DeserializeUser(collection))
+
+	pipelines.GET("/", rpr.recruitmentPipelineHandler.GetAllRecruitmentPipeline)
+	pipelines.GET("/:id", rpr.recruitmentPipelineHandler.GetRecruitmentPipeline)
+	pipelines.GET("/:id/metrics", rpr.recruitmentPipelineHandler.GetRecruitmentPipelineMetrics)
+
+	admin := pipelines.Group("/", middleware.RequireAdmin())
+	admin.POST("/", rpr.recruitmentPipelineHandler.CreateRecruitmentPipeline)
+	admin.PATCH("/:id", rpr.recruitmentPipelineHandler.UpdateRecruitmentPipeline)
+	admin.DELETE("/:id", rpr.recruitmentPipelineHandler.DeleteRecruitmentPipeline)
+}
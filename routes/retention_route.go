@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RetentionRoutes struct {
+	retentionHandler handlers.RetentionHandler
+}
+
+func NewRetentionRoutes(retentionHandler handlers.RetentionHandler) RetentionRoutes {
+	return RetentionRoutes{retentionHandler}
+}
+
+func (rr *RetentionRoutes) SetRetentionRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	policies := router.Group("/retention-policies", middleware.DeserializeUser(collection))
+
+	policies.GET("/", rr.retentionHandler.GetAllRetentionPolicy)
+
+	// 정책 변경과 익명화/삭제는 되돌릴 수 없으므로 관리자만 실행
+	admin := policies.Group("/", middleware.RequireAdmin())
+	admin.POST("/run", rr.retentionHandler.ApplyRetentionPolicies)
+	admin.PUT("/:outcome", rr.retentionHandler.SetRetentionPolicy)
+	admin.DELETE("/:outcome", rr.retentionHandler.DeleteRetentionPolicy)
+
+	router.GET("/erasure-logs", middleware.DeserializeUser(collection), middleware.RequireAdmin(), rr.retentionHandler.GetErasureLogs)
+	router.POST("/candidates/:id/erase", middleware.DeserializeUser(collection), middleware.RequireAdmin(), rr.retentionHandler.EraseCandidate)
+}
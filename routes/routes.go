@@ -5,6 +5,8 @@ import (
 
 	"github.com/Kim-DaeHan/all-note-golang/database"
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/mailer"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/Kim-DaeHan/all-note-golang/services/impl"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,19 +15,46 @@ import (
 )
 
 func SetupRoutes(router *gin.Engine) {
-	apiGroup := router.Group("/api")
+	apiGroup := router.Group("/api", middleware.RequestID(), middleware.IfMatch())
 
-	userRoute.SetUserRoutes(apiGroup)
+	userRoute.SetUserRoutes(apiGroup, userCollection)
 	authRoute.SetAuthRoutes(apiGroup, userCollection)
-	noteRoute.SetNoteRoutes(apiGroup)
-	todoRoute.SetTodoRoutes(apiGroup)
-	projectRoute.SetProjectRoutes(apiGroup)
-	projectTaskRoute.SetProjectTaskRoutes(apiGroup)
-	meetingRoute.SetMeetingRoutes(apiGroup)
-	jobApplicationRoute.SetJobApplicationRoutes(apiGroup)
+	noteRoute.SetNoteRoutes(apiGroup, userCollection)
+	todoRoute.SetTodoRoutes(apiGroup, userCollection)
+	projectRoute.SetProjectRoutes(apiGroup, userCollection)
+	projectTaskRoute.SetProjectTaskRoutes(apiGroup, userCollection)
+	projectTemplateRoute.SetProjectTemplateRoutes(apiGroup, userCollection)
+	timeEntryRoute.SetTimeEntryRoutes(apiGroup, userCollection)
+	meetingRoute.SetMeetingRoutes(apiGroup, userCollection)
+	jobApplicationRoute.SetJobApplicationRoutes(apiGroup, userCollection)
+	recruitmentPipelineRoute.SetRecruitmentPipelineRoutes(apiGroup, userCollection)
+	candidateRoute.SetCandidateRoutes(apiGroup, userCollection)
+	retentionRoute.SetRetentionRoutes(apiGroup, userCollection)
+	scorecardTemplateRoute.SetScorecardTemplateRoutes(apiGroup, userCollection)
+	scorecardRoute.SetScorecardRoutes(apiGroup, userCollection)
+	commentRoute.SetCommentRoutes(apiGroup, userCollection)
+	notificationRoute.SetNotificationRoutes(apiGroup, userCollection)
+	auditRoute.SetAuditRoutes(apiGroup, userCollection)
+	trashRoute.SetTrashRoutes(apiGroup, userCollection)
 }
 
 func SetDependency(db *mongo.Client) {
+	// audit
+	// 다른 서비스들이 변경 이력을 기록하므로 가장 먼저 준비
+	auditCollection = database.GetCollection(db, "audit_logs")
+	auditCollection.Indexes().CreateMany(
+		context.Background(),
+		[]mongo.IndexModel{
+			// 같은 seq로 두 항목이 체인에 들어가지 않도록 보장
+			{Keys: bson.D{{Key: "seq", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "resource", Value: 1}, {Key: "resource_id", Value: 1}}},
+			{Keys: bson.D{{Key: "actor", Value: 1}}},
+		},
+	)
+	auditService = impl.NewAuditServiceImpl(auditCollection)
+	auditHandler = handlers.NewAuditHandler(auditService)
+	auditRoute = NewAuditRoutes(auditHandler)
+
 	// user
 	userCollection = database.GetCollection(db, "users")
 	userCollection.Indexes().CreateOne(
@@ -35,50 +64,185 @@ func SetDependency(db *mongo.Client) {
 			Options: options.Index().SetUnique(true),
 		},
 	)
-	userService = impl.NewUserServiceImpl(userCollection)
+	// 부서/직책 필터 조회용 인덱스
+	userCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{Keys: bson.D{{Key: "department", Value: 1}, {Key: "position", Value: 1}}},
+	)
+	// 유저 삭제 시 넘기거나 지울 데이터의 컬렉션
+	noteCollection = database.GetCollection(db, "notes")
+	todoCollection = database.GetCollection(db, "todos")
+	meetingCollection = database.GetCollection(db, "meetings")
+	projectTaskCollection = database.GetCollection(db, "project_tasks")
+	projectCollection = database.GetCollection(db, "projects")
+	commentCollection = database.GetCollection(db, "comments")
+	timeEntryCollection = database.GetCollection(db, "time_entries")
+	notificationCollection = database.GetCollection(db, "notifications")
+	userService = impl.NewUserServiceImpl(userCollection, noteCollection, todoCollection, meetingCollection, projectTaskCollection, projectCollection, commentCollection, timeEntryCollection, notificationCollection, auditCollection)
 	userHandler = handlers.NewUserHandler(userService)
 	userRoute = NewUserRoutes(userHandler)
 
 	// auth
-	authHandler = handlers.NewAuthHandler(userService)
+	authTokenCollection = database.GetCollection(db, "auth_tokens")
+	// 만료된 인증 토큰은 자동 삭제
+	authTokenCollection.Indexes().CreateMany(
+		context.Background(),
+		[]mongo.IndexModel{
+			{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+		},
+	)
+	authService = impl.NewAuthServiceImpl(userCollection, authTokenCollection, mailer.FromEnv())
+	authHandler = handlers.NewAuthHandler(userService, authService)
 	authRoute = NewAuthRoutes(authHandler)
 
 	// department
 	// departmentCollection = database.GetCollection(db, "departments")
 
 	// note
-	noteCollection = database.GetCollection(db, "notes")
-	noteService = impl.NewNoteServiceImpl(noteCollection)
+	noteService = impl.NewNoteServiceImpl(noteCollection, auditCollection)
 	noteHandler = handlers.NewNoteHandler(noteService)
 	noteRoute = NewNoteRoutes(noteHandler)
 
-	// todo
-	todoCollection = database.GetCollection(db, "todos")
-	todoService = impl.NewTodoServiceImpl(todoCollection)
-	todoHandler = handlers.NewTodoHandler(todoService)
-	todoRoute = NewTodoRoutes(todoHandler)
+	// 멤버 관리 이전에 만든 Project에 소유자 지정
+	impl.MigrateProjectOwners(projectCollection, userCollection)
 
 	// project
-	projectCollection = database.GetCollection(db, "projects")
-	projectService = impl.NewProjectServiceImpl(projectCollection)
+	projectService = impl.NewProjectServiceImpl(projectCollection, projectTaskCollection, todoCollection, auditCollection)
 	projectHandler = handlers.NewProjectHandler(projectService)
 	projectRoute = NewProjectRoutes(projectHandler)
 
+	// todo
+	todoService = impl.NewTodoServiceImpl(todoCollection, projectCollection, auditCollection)
+	todoHandler = handlers.NewTodoHandler(todoService)
+	todoRoute = NewTodoRoutes(todoHandler)
+
 	// project-tasks
-	projectTaskCollection = database.GetCollection(db, "project_tasks")
-	projectTaskService = impl.NewProjectTaskServiceImpl(projectTaskCollection)
+	projectTaskService = impl.NewProjectTaskServiceImpl(projectTaskCollection, projectCollection, auditCollection)
 	projectTaskHandler = handlers.NewProjectTaskHandler(projectTaskService)
 	projectTaskRoute = NewProjectTaskRoutes(projectTaskHandler)
 
+	// project-template
+	projectTemplateCollection = database.GetCollection(db, "project_templates")
+	projectTemplateService = impl.NewProjectTemplateServiceImpl(projectTemplateCollection, projectCollection, projectTaskCollection, auditCollection)
+	projectTemplateHandler = handlers.NewProjectTemplateHandler(projectTemplateService)
+	projectTemplateRoute = NewProjectTemplateRoutes(projectTemplateHandler)
+
+	// time-entry
+	// 유저당 실행 중인 타이머는 하나만 허용
+	timeEntryCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "user", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.D{{Key: "running", Value: true}}),
+		},
+	)
+	timeEntryService = impl.NewTimeEntryServiceImpl(timeEntryCollection, projectCollection, projectTaskCollection, todoCollection)
+	timeEntryHandler = handlers.NewTimeEntryHandler(timeEntryService)
+	timeEntryRoute = NewTimeEntryRoutes(timeEntryHandler)
+
 	// meeting
-	meetingCollection = database.GetCollection(db, "meetings")
-	meetingService = impl.NewMeetingServiceImpl(meetingCollection)
+	meetingCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "interview.job_application", Value: 1}, {Key: "start_dt", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	)
+	meetingService = impl.NewMeetingServiceImpl(meetingCollection, todoCollection, auditCollection)
 	meetingHandler = handlers.NewMeetingHandler(meetingService)
 	meetingRoute = NewMeetingRoutes(meetingHandler)
 
-	// job-application
+	// recruitment-pipeline
 	jobApplicationCollection = database.GetCollection(db, "job_applications")
-	jobApplicationService = impl.NewJobApplicationServiceImpl(jobApplicationCollection)
+	recruitmentPipelineCollection = database.GetCollection(db, "recruitment_pipelines")
+	// 부서당 채용 파이프라인은 하나만 허용
+	recruitmentPipelineCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "department", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	recruitmentPipelineService = impl.NewRecruitmentPipelineServiceImpl(recruitmentPipelineCollection, jobApplicationCollection)
+	recruitmentPipelineHandler = handlers.NewRecruitmentPipelineHandler(recruitmentPipelineService)
+	recruitmentPipelineRoute = NewRecruitmentPipelineRoutes(recruitmentPipelineHandler)
+
+	// candidate
+	candidateCollection = database.GetCollection(db, "candidates")
+	// 중복 검사 조회용 인덱스
+	candidateCollection.Indexes().CreateMany(
+		context.Background(),
+		[]mongo.IndexModel{
+			{Keys: bson.D{{Key: "email", Value: 1}}},
+			{Keys: bson.D{{Key: "phone_key", Value: 1}}},
+			{Keys: bson.D{{Key: "name_key", Value: 1}}},
+		},
+	)
+	candidateService = impl.NewCandidateServiceImpl(candidateCollection, jobApplicationCollection)
+	candidateHandler = handlers.NewCandidateHandler(candidateService)
+	candidateRoute = NewCandidateRoutes(candidateHandler)
+
+	// retention
+	retentionCollection = database.GetCollection(db, "retention_policies")
+	// 지원 결과당 보존 정책은 하나만 허용
+	retentionCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "outcome", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	erasureLogCollection = database.GetCollection(db, "erasure_logs")
+	retentionService = impl.NewRetentionServiceImpl(retentionCollection, erasureLogCollection, jobApplicationCollection, candidateCollection, meetingCollection, auditCollection)
+	retentionHandler = handlers.NewRetentionHandler(retentionService)
+	retentionRoute = NewRetentionRoutes(retentionHandler)
+
+	// job-application
+	jobApplicationService = impl.NewJobApplicationServiceImpl(jobApplicationCollection, recruitmentPipelineCollection, meetingCollection, candidateCollection, auditCollection)
 	jobApplicationHandler = handlers.NewJobApplicationHandler(jobApplicationService)
 	jobApplicationRoute = NewJobApplicationRoutes(jobApplicationHandler)
+
+	// scorecard-template
+	scorecardTemplateCollection = database.GetCollection(db, "scorecard_templates")
+	// 포지션당 평가표 템플릿은 하나만 허용
+	scorecardTemplateCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "position", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	scorecardTemplateService = impl.NewScorecardTemplateServiceImpl(scorecardTemplateCollection)
+	scorecardTemplateHandler = handlers.NewScorecardTemplateHandler(scorecardTemplateService)
+	scorecardTemplateRoute = NewScorecardTemplateRoutes(scorecardTemplateHandler)
+
+	// scorecard
+	scorecardCollection = database.GetCollection(db, "scorecards")
+	// 면접관은 지원서당 평가표를 하나만 제출
+	scorecardCollection.Indexes().CreateOne(
+		context.Background(),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "job_application", Value: 1}, {Key: "interviewer", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	scorecardService = impl.NewScorecardServiceImpl(scorecardCollection, scorecardTemplateCollection, jobApplicationCollection, meetingCollection, auditCollection)
+	scorecardHandler = handlers.NewScorecardHandler(scorecardService)
+	scorecardRoute = NewScorecardRoutes(scorecardHandler)
+
+	// notification
+	notificationService = impl.NewNotificationServiceImpl(notificationCollection)
+	notificationHandler = handlers.NewNotificationHandler(notificationService)
+	notificationRoute = NewNotificationRoutes(notificationHandler)
+
+	// comment
+	commentService = impl.NewCommentServiceImpl(commentCollection, notificationCollection, userCollection, projectCollection, projectTaskCollection, todoCollection, jobApplicationCollection)
+	commentHandler = handlers.NewCommentHandler(commentService)
+	commentRoute = NewCommentRoutes(commentHandler)
+
+	// trash
+	trashService = impl.NewTrashServiceImpl(noteCollection, todoCollection, projectCollection, projectTaskCollection, meetingCollection, jobApplicationCollection, commentCollection, timeEntryCollection, notificationCollection, auditCollection)
+	trashHandler = handlers.NewTrashHandler(trashService)
+	trashRoute = NewTrashRoutes(trashHandler)
 }
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ScorecardRoutes struct {
+	scorecardHandler handlers.ScorecardHandler
+}
+
+func NewScorecardRoutes(scorecardHandler handlers.ScorecardHandler) ScorecardRoutes {
+	return ScorecardRoutes{scorecardHandler}
+}
+
+func (sr *ScorecardRoutes) SetScorecardRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	jobApplications := router.Group("/jobApplications/:id", middleware.DeserializeUser(collection))
+
+	jobApplications.GET("/scorecards", sr.scorecardHandler.GetScorecardByJobApplication)
+	jobApplications.GET("/scorecards/summary", sr.scorecardHandler.GetScorecardSummary)
+	jobApplications.POST("/scorecards", sr.scorecardHandler.SubmitScorecard)
+	jobApplications.PUT("/decision", sr.scorecardHandler.DecideJobApplication)
+
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ScorecardTemplateRoutes struct {
+	scorecardTemplateHandler handlers.ScorecardTemplateHandler
+}
+
+func NewScorecardTemplateRoutes(scorecardTemplateHandler handlers.ScorecardTemplateHandler) ScorecardTemplateRoutes {
+	return ScorecardTemplateRoutes{scorecardTemplateHandler}
+}
+
+func (str *ScorecardTemplateRoutes) SetScorecardTemplateRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	templates := router.Group("/scorecard-templates", middleware.DeserializeUser(collection))
+
+	templates.GET("/", str.scorecardTemplateHandler.GetAllScorecardTemplate)
+	templates.GET("/:id", str.scorecardTemplateHandler.GetScorecardTemplate)
+
+	admin := templates.Group("/", middleware.RequireAdmin())
+	admin.POST("/", str.scorecardTemplateHandler.CreateScorecardTemplate)
+	admin.PATCH("/:id", str.scorecardTemplateHandler.UpdateScorecardTemplate)
+	admin.DELETE("/:id", str.scorecardTemplateHandler.DeleteScorecardTemplate)
+
+}
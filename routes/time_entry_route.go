@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type TimeEntryRoutes struct {
+	timeEntryHandler handlers.TimeEntryHandler
+}
+
+func NewTimeEntryRoutes(timeEntryHandler handlers.TimeEntryHandler) TimeEntryRoutes {
+	return TimeEntryRoutes{timeEntryHandler}
+}
+
+func (ter *TimeEntryRoutes) SetTimeEntryRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	entries := router.Group("/time-entries", middleware.DeserializeUser(collection))
+
+	entries.GET("/", ter.timeEntryHandler.GetMyTimeEntry)
+	entries.GET("/report", ter.timeEntryHandler.GetTimeReport)
+	entries.GET("/projects/:id/estimates", ter.timeEntryHandler.GetProjectTimeEstimates)
+	entries.POST("/", ter.timeEntryHandler.CreateTimeEntry)
+	entries.POST("/start", ter.timeEntryHandler.StartTimer)
+	entries.POST("/stop", ter.timeEntryHandler.StopTimer)
+	entries.DELETE("/:id", ter.timeEntryHandler.DeleteTimeEntry)
+
+}
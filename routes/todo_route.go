@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type TodoRoutes struct {
@@ -13,8 +15,8 @@ func NewTodoRoutes(todoHandler handlers.TodoHandler) TodoRoutes {
 	return TodoRoutes{todoHandler}
 }
 
-func (tr *TodoRoutes) SetTodoRoutes(router *gin.RouterGroup) {
-	todos := router.Group("/todos")
+func (tr *TodoRoutes) SetTodoRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	todos := router.Group("/todos", middleware.DeserializeUser(collection))
 
 	todos.GET("/", tr.todoHandler.GetAllTodo)
 	todos.GET("/:id", tr.todoHandler.GetTodo)
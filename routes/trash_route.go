@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type TrashRoutes struct {
+	trashHandler handlers.TrashHandler
+}
+
+func NewTrashRoutes(trashHandler handlers.TrashHandler) TrashRoutes {
+	return TrashRoutes{trashHandler}
+}
+
+func (tr *TrashRoutes) SetTrashRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
+	trash := router.Group("/trash", middleware.DeserializeUser(collection))
+
+	trash.GET("/", tr.trashHandler.GetTrash)
+	trash.POST("/:resource/:id/restore", tr.trashHandler.RestoreTrashItem)
+}
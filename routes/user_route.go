@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Kim-DaeHan/all-note-golang/handlers"
+	"github.com/Kim-DaeHan/all-note-golang/middleware"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type UserRoutes struct {
@@ -13,12 +15,19 @@ func NewUserRoutes(userHandler handlers.UserHandler) UserRoutes {
 	return UserRoutes{userHandler}
 }
 
-func (ur *UserRoutes) SetUserRoutes(router *gin.RouterGroup) {
+func (ur *UserRoutes) SetUserRoutes(router *gin.RouterGroup, collection *mongo.Collection) {
 	users := router.Group("/users")
 
 	users.GET("/", ur.userHandler.GetAllUser)
+	users.GET("/search", ur.userHandler.SearchUsers)
 	users.GET("/:id", ur.userHandler.GetUser)
-	users.POST("/", ur.userHandler.CreateUser)
-	users.POST("/upsert", ur.userHandler.UpsertUser)
 
+	admin := users.Group("/", middleware.DeserializeUser(collection), middleware.RequireAdmin())
+	admin.POST("/", ur.userHandler.CreateUser)
+	admin.POST("/upsert", ur.userHandler.UpsertUser)
+	admin.PATCH("/:id/deactivate", ur.userHandler.DeactivateUser)
+	admin.PATCH("/:id/reactivate", ur.userHandler.ReactivateUser)
+	admin.DELETE("/:id", ur.userHandler.DeleteUser)
+
+	router.PATCH("/me", middleware.DeserializeUser(collection), ur.userHandler.UpdateMe)
 }
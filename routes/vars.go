@@ -14,8 +14,10 @@ var (
 	userRoute      UserRoutes
 
 	// auth
-	authHandler handlers.AuthHandler
-	authRoute   AuthRoutes
+	authTokenCollection *mongo.Collection
+	authService         services.AuthService
+	authHandler         handlers.AuthHandler
+	authRoute           AuthRoutes
 
 	// note
 	noteCollection *mongo.Collection
@@ -41,6 +43,30 @@ var (
 	projectTaskHandler    handlers.ProjectTaskHandler
 	projectTaskRoute      ProjectTaskRoutes
 
+	// project-template
+	projectTemplateCollection *mongo.Collection
+	projectTemplateService    services.ProjectTemplateService
+	projectTemplateHandler    handlers.ProjectTemplateHandler
+	projectTemplateRoute      ProjectTemplateRoutes
+
+	// time-entry
+	timeEntryCollection *mongo.Collection
+	timeEntryService    services.TimeEntryService
+	timeEntryHandler    handlers.TimeEntryHandler
+	timeEntryRoute      TimeEntryRoutes
+
+	// comment
+	commentCollection *mongo.Collection
+	commentService    services.CommentService
+	commentHandler    handlers.CommentHandler
+	commentRoute      CommentRoutes
+
+	// notification
+	notificationCollection *mongo.Collection
+	notificationService    services.NotificationService
+	notificationHandler    handlers.NotificationHandler
+	notificationRoute      NotificationRoutes
+
 	// meeting
 	meetingCollection *mongo.Collection
 	meetingService    services.MeetingService
@@ -52,4 +78,46 @@ var (
 	jobApplicationService    services.JobApplicationService
 	jobApplicationHandler    handlers.JobApplicationHandler
 	jobApplicationRoute      JobApplicationRoutes
+
+	// candidate
+	candidateCollection *mongo.Collection
+	candidateService    services.CandidateService
+	candidateHandler    handlers.CandidateHandler
+	candidateRoute      CandidateRoutes
+
+	// retention
+	retentionCollection  *mongo.Collection
+	erasureLogCollection *mongo.Collection
+	retentionService     services.RetentionService
+	retentionHandler     handlers.RetentionHandler
+	retentionRoute       RetentionRoutes
+
+	// recruitment-pipeline
+	recruitmentPipelineCollection *mongo.Collection
+	recruitmentPipelineService    services.RecruitmentPipelineService
+	recruitmentPipelineHandler    handlers.RecruitmentPipelineHandler
+	recruitmentPipelineRoute      RecruitmentPipelineRoutes
+
+	// scorecard-template
+	scorecardTemplateCollection *mongo.Collection
+	scorecardTemplateService    services.ScorecardTemplateService
+	scorecardTemplateHandler    handlers.ScorecardTemplateHandler
+	scorecardTemplateRoute      ScorecardTemplateRoutes
+
+	// scorecard
+	scorecardCollection *mongo.Collection
+	scorecardService    services.ScorecardService
+	scorecardHandler    handlers.ScorecardHandler
+	scorecardRoute      ScorecardRoutes
+
+	// audit
+	auditCollection *mongo.Collection
+	auditService    services.AuditService
+	auditHandler    handlers.AuditHandler
+	auditRoute      AuditRoutes
+
+	// trash
+	trashService services.TrashService
+	trashHandler handlers.TrashHandler
+	trashRoute   TrashRoutes
 )
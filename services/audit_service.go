@@ -0,0 +1,11 @@
+package services
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type AuditService interface {
+	GetAuditLogs(query *dto.AuditLogQueryDTO) ([]models.AuditLog, error)
+	VerifyAuditLogs() (*models.AuditVerification, error)
+}
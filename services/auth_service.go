@@ -0,0 +1,22 @@
+package services
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type AuthService interface {
+	Register(dto *dto.RegisterDTO) (*models.User, error)
+	VerifyEmail(dto *dto.VerifyEmailDTO) (*models.User, error)
+	Login(dto *dto.LoginDTO) (*models.User, error)
+	ForgotPassword(dto *dto.PasswordForgotDTO) error
+	ResetPassword(dto *dto.PasswordResetDTO) error
+	RequiresTOTP(user *models.User) bool
+	Challenge(user *models.User) (*models.MFAChallenge, error)
+	GetChallenge(mfaToken string) (*models.MFAChallenge, error)
+	VerifyMFA(dto *dto.MFAVerifyDTO) (*models.User, *models.RecoveryCodes, error)
+	EnrollTOTP(userId string) (*models.TOTPEnrollment, error)
+	ConfirmTOTP(userId string, dto *dto.TOTPCodeDTO) (*models.RecoveryCodes, error)
+	RegenerateRecoveryCodes(userId string, dto *dto.TOTPCodeDTO) (*models.RecoveryCodes, error)
+	DisableTOTP(userId string, dto *dto.TOTPCodeDTO) error
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type CandidateService interface {
+	GetAllCandidate() ([]models.Candidate, error)
+	GetCandidateProfile(id string) (*models.CandidateProfile, error)
+	GetCandidateDuplicates(id string) ([]models.CandidateMatch, error)
+	CreateCandidate(dto *dto.CandidateCreateDTO) (*models.Candidate, error)
+	UpdateCandidate(ctx context.Context, id string, dto *dto.CandidateUpdateDTO) (*models.Candidate, error)
+	MergeCandidate(id string, dto *dto.CandidateMergeDTO) (*models.CandidateProfile, error)
+	DeleteCandidate(id string) error
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type CommentService interface {
+	GetCommentByTarget(query *dto.CommentQueryDTO, userId string) ([]models.Comment, error)
+	CreateComment(dto *dto.CommentCreateDTO, userId string) (*models.Comment, error)
+	UpdateComment(ctx context.Context, id string, dto *dto.CommentUpdateDTO, userId string) (*models.Comment, error)
+	DeleteComment(ctx context.Context, id string, userId string) error
+}
@@ -0,0 +1,512 @@
+package impl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/audit"
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 감사 로그 조회 기본/최대 개수, seq 충돌 시 재시도 횟수
+const (
+	auditLogLimit       = 100
+	auditLogMaxLimit    = 1000
+	auditInsertAttempts = 5
+)
+
+// 체인 head를 저장하는 컬렉션과 문서 ID
+const (
+	auditHeadCollectionName = "audit_heads"
+	auditHeadID             = "audit_logs"
+)
+
+// 변경 이력에 값을 남기지 않는 필드
+var auditRedactedFields = map[string]bool{
+	"password_hash": true,
+	"totp":          true,
+}
+
+// 지원자 개인정보가 들어가는 리소스별 필드, 지원자 정보를 삭제한 뒤에도 감사 로그에 남지 않도록 값을 가림
+var auditPersonalFields = map[string][]string{
+	models.AuditResourceJobApplication: {"applicant_name", "attachments"},
+}
+
+// 면접 Meeting은 제목과 설명에 지원자 이름이 들어감
+var interviewPersonalFields = []string{"title", "description"}
+
+type AuditServiceImpl struct {
+	collection *mongo.Collection
+}
+
+func NewAuditServiceImpl(collection *mongo.Collection) services.AuditService {
+	return &AuditServiceImpl{collection}
+}
+
+func (as *AuditServiceImpl) GetAuditLogs(query *dto.AuditLogQueryDTO) ([]models.AuditLog, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logs := []models.AuditLog{}
+
+	filter := bson.M{}
+
+	if query.Resource != "" {
+		filter["resource"] = query.Resource
+	}
+
+	if query.ResourceID != "" {
+		resourceId, err := utils.ConvertToObjectId(query.ResourceID)
+		if err != nil {
+			return nil, utils.ConvertError("Resource", err)
+		}
+		filter["resource_id"] = resourceId
+	}
+
+	if query.Action != "" {
+		filter["action"] = query.Action
+	}
+
+	if query.Actor != "" {
+		actorId, err := utils.ConvertToObjectId(query.Actor)
+		if err != nil {
+			return nil, utils.ConvertError("User", err)
+		}
+		filter["actor"] = actorId
+	}
+
+	if query.RequestID != "" {
+		filter["request_id"] = query.RequestID
+	}
+
+	if period := periodFilter(query.From, query.To); len(period) > 0 {
+		filter["created_at"] = period
+	}
+
+	limit := min(query.Limit, auditLogMaxLimit)
+	if limit <= 0 {
+		limit = auditLogLimit
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "seq", Value: -1}}).SetLimit(int64(limit))
+
+	results, err := as.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &logs); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return logs, nil
+}
+
+func (as *AuditServiceImpl) VerifyAuditLogs() (*models.AuditVerification, error) {
+	// 전체 체인을 순서대로 다시 계산하므로 조회보다 긴 시간 허용
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	verification := &models.AuditVerification{Valid: true}
+	prevHash := ""
+
+	// 검증 중에 추가되는 항목은 head 이후이므로 제외
+	head, err := loadAuditHead(ctx, auditHeadCollection(as.collection))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	filter := bson.M{}
+	if head != nil {
+		filter["seq"] = bson.M{"$lte": head.Seq}
+	}
+
+	results, err := as.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	for results.Next(ctx) {
+		var entry models.AuditLog
+		if err := results.Decode(&entry); err != nil {
+			return nil, &errors.CustomError{
+				Message:    "결과 디코딩 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		reason := ""
+		switch {
+		case entry.Seq != verification.Checked+1:
+			reason = fmt.Sprintf("seq %d 다음에 %d가 이어짐", verification.Checked, entry.Seq)
+		case entry.PrevHash != prevHash:
+			reason = "이전 항목의 hash와 prev_hash가 다름"
+		case auditHash(&entry) != entry.Hash:
+			reason = "내용과 hash가 다름"
+		}
+
+		if reason != "" {
+			seq := entry.Seq
+			verification.Valid = false
+			verification.BrokenAt = &seq
+			verification.Reason = reason
+			return verification, nil
+		}
+
+		verification.Checked = entry.Seq
+		prevHash = entry.Hash
+	}
+
+	if err := results.Err(); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	// 마지막 항목까지 함께 지우면 체인만으로는 알 수 없으므로 head와 비교
+	reason := ""
+	switch {
+	case head == nil:
+		if verification.Checked > 0 {
+			reason = "체인 head가 없음"
+		}
+	case auditHeadSignature(head.Seq, head.Hash) != head.Signature:
+		reason = "체인 head의 서명이 다름"
+	case head.Seq != verification.Checked || head.Hash != prevHash:
+		reason = fmt.Sprintf("체인 head는 seq %d인데 마지막 항목은 seq %d, 뒤쪽 항목이 삭제되었을 수 있음", head.Seq, verification.Checked)
+	}
+
+	if reason != "" {
+		seq := verification.Checked + 1
+		verification.Valid = false
+		verification.BrokenAt = &seq
+		verification.Reason = reason
+	}
+
+	return verification, nil
+}
+
+// auditSnapshot 감사 로그용 문서 스냅샷, 없으면 nil
+func auditSnapshot(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID) bson.D {
+	var doc bson.D
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.Printf("audit snapshot %s/%s failed: %v", collection.Name(), id.Hex(), err)
+		}
+		return nil
+	}
+	return doc
+}
+
+// recordChange 변경 후 문서를 다시 읽어 before와 비교하여 기록, 생성은 before가 nil
+func recordChange(ctx context.Context, auditCollection *mongo.Collection, collection *mongo.Collection, resource string, id primitive.ObjectID, before bson.D) error {
+	action := models.AuditActionUpdate
+	if before == nil {
+		action = models.AuditActionCreate
+	}
+	return recordAudit(ctx, auditCollection, resource, action, id, before, auditSnapshot(ctx, collection, id))
+}
+
+// recordAudit 감사 로그를 hash 체인에 추가하고 체인 head를 같은 트랜잭션에서 옮김
+// 기록하지 못하면 누가 바꿨는지 남지 않으므로 요청을 실패시킴
+func recordAudit(ctx context.Context, auditCollection *mongo.Collection, resource string, action string, id primitive.ObjectID, before bson.D, after bson.D) error {
+	changes := auditChanges(before, after, personalFields(resource, before, after))
+	if action == models.AuditActionUpdate && len(changes) == 0 {
+		return nil
+	}
+
+	meta := audit.FromContext(ctx)
+
+	entry := models.AuditLog{
+		Resource:   resource,
+		ResourceID: id,
+		Action:     action,
+		Actor:      meta.Actor,
+		RequestID:  meta.RequestID,
+		IP:         meta.IP,
+		Changes:    changes,
+		// DB에 저장되는 정밀도(밀리초)로 맞춰야 검증 시 같은 hash가 나옴
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+
+	// 동시에 기록하면 같은 seq로 충돌하므로 head를 다시 읽어 재시도
+	var err error
+	for attempt := 0; attempt < auditInsertAttempts; attempt++ {
+		if err = appendAudit(ctx, auditCollection, &entry); err == nil {
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			break
+		}
+	}
+
+	log.Printf("audit %s %s/%s failed: %v", action, resource, id.Hex(), err)
+
+	return &errors.CustomError{
+		Message:    "감사 로그 기록 실패",
+		StatusCode: http.StatusInternalServerError,
+		Err:        err,
+	}
+}
+
+// appendAudit 트랜잭션 안에서 head 다음 seq로 항목을 추가하고 head를 옮김
+// 다른 요청이 먼저 head를 옮겼으면 head upsert가 중복 키로 실패
+func appendAudit(ctx context.Context, auditCollection *mongo.Collection, entry *models.AuditLog) error {
+	heads := auditHeadCollection(auditCollection)
+
+	session, err := auditCollection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		head, err := loadAuditHead(sessCtx, heads)
+		if err != nil {
+			return nil, err
+		}
+
+		// head를 두기 전에 쌓인 체인은 마지막 항목을 head로 이어 받음
+		if head == nil {
+			var last models.AuditLog
+			err := auditCollection.FindOne(sessCtx, bson.M{}, options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}})).Decode(&last)
+			if err != nil && err != mongo.ErrNoDocuments {
+				return nil, err
+			}
+			head = &models.AuditHead{Seq: last.Seq, Hash: last.Hash}
+		}
+
+		entry.ID = primitive.NewObjectID()
+		entry.Seq = head.Seq + 1
+		entry.PrevHash = head.Hash
+		entry.Hash = auditHash(entry)
+
+		if _, err := auditCollection.InsertOne(sessCtx, entry); err != nil {
+			return nil, err
+		}
+
+		filter := bson.M{"_id": auditHeadID, "seq": head.Seq}
+		update := bson.M{"$set": bson.M{
+			"seq":        entry.Seq,
+			"hash":       entry.Hash,
+			"signature":  auditHeadSignature(entry.Seq, entry.Hash),
+			"updated_at": entry.CreatedAt,
+		}}
+		return heads.UpdateOne(sessCtx, filter, update, options.Update().SetUpsert(true))
+	})
+
+	return err
+}
+
+// auditHeadCollection 감사 로그 컬렉션과 같은 DB의 head 컬렉션
+func auditHeadCollection(auditCollection *mongo.Collection) *mongo.Collection {
+	return auditCollection.Database().Collection(auditHeadCollectionName)
+}
+
+// loadAuditHead 체인 head 조회, 아직 없으면 nil
+func loadAuditHead(ctx context.Context, heads *mongo.Collection) (*models.AuditHead, error) {
+	var head models.AuditHead
+	if err := heads.FindOne(ctx, bson.M{"_id": auditHeadID}).Decode(&head); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &head, nil
+}
+
+// auditHeadSignature head의 seq와 hash를 서버 키로 서명, DB 쓰기 권한만으로는 head를 되돌려 맞출 수 없음
+func auditHeadSignature(seq int64, hash string) string {
+	mac := hmac.New(sha256.New, auditKey())
+	mac.Write([]byte(fmt.Sprintf("head:%d:%s", seq, hash)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// auditChanges 최상위 필드 단위로 before/after를 비교, updated_at은 제외하고 민감한 필드와 개인정보 필드는 값을 가림
+func auditChanges(before bson.D, after bson.D, personal map[string]bool) []models.AuditChange {
+	beforeMap := docFields(before)
+	afterMap := docFields(after)
+
+	fields := []string{}
+	for field := range beforeMap {
+		fields = append(fields, field)
+	}
+	for field := range afterMap {
+		if _, ok := beforeMap[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := []models.AuditChange{}
+	for _, field := range fields {
+		if field == "updated_at" {
+			continue
+		}
+
+		beforeValue, afterValue := beforeMap[field], afterMap[field]
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+
+		if auditRedactedFields[field] || personal[field] {
+			beforeValue, afterValue = redact(beforeValue), redact(afterValue)
+		}
+
+		changes = append(changes, models.AuditChange{Field: field, Before: beforeValue, After: afterValue})
+	}
+
+	return changes
+}
+
+// personalFields 리소스에서 값을 가릴 개인정보 필드
+func personalFields(resource string, before bson.D, after bson.D) map[string]bool {
+	fields := auditPersonalFields[resource]
+	if resource == models.AuditResourceMeeting && (docFields(before)["interview"] != nil || docFields(after)["interview"] != nil) {
+		fields = interviewPersonalFields
+	}
+
+	personal := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		personal[field] = true
+	}
+	return personal
+}
+
+func docFields(doc bson.D) map[string]interface{} {
+	fields := make(map[string]interface{}, len(doc))
+	for _, e := range doc {
+		fields[e.Key] = e.Value
+	}
+	return fields
+}
+
+func redact(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return "[redacted]"
+}
+
+// auditHash 이전 항목의 hash와 현재 항목 내용을 이어 서버 키로 HMAC-SHA256
+// DB 쓰기 권한만으로는 항목을 고친 뒤 hash를 다시 계산할 수 없음
+func auditHash(entry *models.AuditLog) string {
+	content, err := bson.Marshal(bson.D{
+		{Key: "seq", Value: entry.Seq},
+		{Key: "prev_hash", Value: entry.PrevHash},
+		{Key: "resource", Value: entry.Resource},
+		{Key: "resource_id", Value: entry.ResourceID},
+		{Key: "action", Value: entry.Action},
+		{Key: "actor", Value: entry.Actor},
+		{Key: "request_id", Value: entry.RequestID},
+		{Key: "ip", Value: entry.IP},
+		{Key: "changes", Value: entry.Changes},
+		{Key: "created_at", Value: entry.CreatedAt},
+	})
+	if err != nil {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, auditKey())
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// auditKey 감사 로그 서명 키 (AUDIT_HMAC_KEY), 없으면 access 토큰 키에서 분리한 키를 사용
+func auditKey() []byte {
+	if key := os.Getenv("AUDIT_HMAC_KEY"); key != "" {
+		return []byte(key)
+	}
+	return []byte(os.Getenv("ACCESS_TOKEN_JWT_SECRET") + ":audit")
+}
+
+// auditBatch 일괄 변경 전 문서 스냅샷, 변경 후 건별로 감사 로그를 남김
+// 트랜잭션 안에서는 seq 충돌을 재시도할 수 없으므로 트랜잭션 밖에서 읽고 커밋 후 기록
+type auditBatch struct {
+	collection *mongo.Collection
+	resource   string
+	before     []bson.D
+}
+
+func newAuditBatch(ctx context.Context, collection *mongo.Collection, resource string, filter bson.M) *auditBatch {
+	batch := &auditBatch{collection: collection, resource: resource}
+
+	results, err := collection.Find(ctx, filter)
+	if err != nil {
+		log.Printf("audit snapshot %s failed: %v", collection.Name(), err)
+		return batch
+	}
+
+	defer results.Close(ctx)
+
+	if err := results.All(ctx, &batch.before); err != nil {
+		log.Printf("audit snapshot %s failed: %v", collection.Name(), err)
+	}
+
+	return batch
+}
+
+// record 변경 후 문서를 다시 읽어 비교, 문서가 없어졌거나 휴지통으로 옮겨졌으면 삭제로 기록
+// 기록하지 못한 항목이 있어도 나머지는 기록하고 처음 실패한 오류를 반환
+func (b *auditBatch) record(ctx context.Context, auditCollection *mongo.Collection) error {
+	var firstErr error
+	for _, before := range b.before {
+		id, ok := docFields(before)["_id"].(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+
+		after := auditSnapshot(ctx, b.collection, id)
+
+		action := models.AuditActionUpdate
+		if after == nil || (docFields(after)["deleted_at"] != nil && docFields(before)["deleted_at"] == nil) {
+			action = models.AuditActionDelete
+		}
+
+		if err := recordAudit(ctx, auditCollection, b.resource, action, id, before, after); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,428 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	anErr "github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/mailer"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 인증 토큰 유효 기간
+const (
+	emailVerificationTTL = 24 * time.Hour
+	passwordResetTTL     = time.Hour
+)
+
+type AuthServiceImpl struct {
+	collection      *mongo.Collection
+	tokenCollection *mongo.Collection
+	mailer          mailer.Mailer
+}
+
+func NewAuthServiceImpl(collection *mongo.Collection, tokenCollection *mongo.Collection, mailer mailer.Mailer) services.AuthService {
+	return &AuthServiceImpl{collection, tokenCollection, mailer}
+}
+
+func (as *AuthServiceImpl) Register(dto *dto.RegisterDTO) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	email := utils.NormalizeEmail(dto.Email)
+
+	count, err := as.collection.CountDocuments(ctx, bson.M{"email": email})
+	if err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count > 0 {
+		return nil, &anErr.CustomError{
+			Message:    "이미 가입된 이메일",
+			StatusCode: http.StatusConflict,
+			Err:        errors.New("email already registered"),
+		}
+	}
+
+	hash, err := utils.HashPassword(dto.Password)
+	if err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "비밀번호 암호화 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	now := time.Now()
+	verified := false
+
+	user := models.User{
+		ID:           primitive.NewObjectID(),
+		Email:        email,
+		UserName:     dto.UserName,
+		Verified:     &verified,
+		Provider:     models.UserProviderLocal,
+		PasswordHash: hash,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if isAdminEmail(email) {
+		user.Role = models.UserRoleAdmin
+	}
+
+	if _, err := as.collection.InsertOne(ctx, user); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &anErr.CustomError{
+				Message:    "이미 가입된 이메일",
+				StatusCode: http.StatusConflict,
+				Err:        err,
+			}
+		}
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	token, err := as.issueToken(ctx, user.ID, models.AuthTokenEmailVerification, emailVerificationTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	as.send(email, "[All Note] 이메일 인증", fmt.Sprintf("아래 링크에서 이메일 인증을 완료해 주세요. (%d시간 동안 유효)\n\n%s", int(emailVerificationTTL.Hours()), clientLink("/verify-email", token)))
+
+	return &user, nil
+}
+
+func (as *AuthServiceImpl) VerifyEmail(dto *dto.VerifyEmailDTO) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	authToken, err := as.consumeToken(ctx, dto.Token, models.AuthTokenEmailVerification)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	update := bson.M{"$set": bson.M{"verified": true, "updated_at": time.Now()}}
+
+	var user models.User
+	if err := as.collection.FindOneAndUpdate(ctx, bson.M{"_id": authToken.User}, update, opts).Decode(&user); err != nil {
+		return nil, findError("User를 찾을 수 없음", err)
+	}
+
+	return &user, nil
+}
+
+func (as *AuthServiceImpl) Login(dto *dto.LoginDTO) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	invalidErr := &anErr.CustomError{
+		Message:    "이메일 또는 비밀번호가 올바르지 않음",
+		StatusCode: http.StatusUnauthorized,
+		Err:        errors.New("invalid credentials"),
+	}
+
+	var user models.User
+	if err := as.collection.FindOne(ctx, bson.M{"email": utils.NormalizeEmail(dto.Email)}).Decode(&user); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, invalidErr
+		}
+		return nil, findError("User를 찾을 수 없음", err)
+	}
+
+	// Google로만 가입한 유저는 비밀번호가 없음
+	if user.PasswordHash == "" {
+		return nil, invalidErr
+	}
+
+	now := time.Now()
+
+	if user.IsLocked(now) {
+		return nil, &anErr.CustomError{
+			Message:    fmt.Sprintf("로그인 실패가 반복되어 %s까지 잠긴 계정", user.LockedUntil.Format(time.RFC3339)),
+			StatusCode: http.StatusLocked,
+			Err:        errors.New("account locked"),
+		}
+	}
+
+	if !utils.CheckPassword(user.PasswordHash, dto.Password) {
+		if err := as.recordFailedLogin(ctx, user.ID, now); err != nil {
+			return nil, err
+		}
+		return nil, invalidErr
+	}
+
+	if !user.IsVerified() {
+		return nil, &anErr.CustomError{
+			Message:    "이메일 인증이 필요함",
+			StatusCode: http.StatusForbidden,
+			Err:        errors.New("email not verified"),
+		}
+	}
+
+	// 2단계 인증이 남아 있으면 VerifyMFA가 같은 실패 횟수를 쓰므로 인증 코드까지 맞힌 뒤에 초기화
+	// 여기서 초기화하면 비밀번호를 다시 보내는 것만으로 인증 코드 대입 시도의 잠금을 피할 수 있음
+	if as.requiresMFA(&user) {
+		return &user, nil
+	}
+
+	if err := as.clearFailedLogins(ctx, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (as *AuthServiceImpl) ForgotPassword(dto *dto.PasswordForgotDTO) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var user models.User
+	if err := as.collection.FindOne(ctx, bson.M{"email": utils.NormalizeEmail(dto.Email)}).Decode(&user); err != nil {
+		// 가입 여부가 드러나지 않도록 없는 이메일도 성공으로 응답
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return findError("User를 찾을 수 없음", err)
+	}
+
+	if user.PasswordHash == "" || !user.IsActive() {
+		return nil
+	}
+
+	// 이전에 발급한 재설정 토큰은 무효화
+	filter := bson.M{"user": user.ID, "purpose": models.AuthTokenPasswordReset, "used_at": bson.M{"$exists": false}}
+	if _, err := as.tokenCollection.DeleteMany(ctx, filter); err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	token, err := as.issueToken(ctx, user.ID, models.AuthTokenPasswordReset, passwordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	as.send(user.Email, "[All Note] 비밀번호 재설정", fmt.Sprintf("아래 링크에서 비밀번호를 재설정해 주세요. (%d분 동안 유효, 한 번만 사용 가능)\n\n%s", int(passwordResetTTL.Minutes()), clientLink("/reset-password", token)))
+
+	return nil
+}
+
+func (as *AuthServiceImpl) ResetPassword(dto *dto.PasswordResetDTO) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	hash, err := utils.HashPassword(dto.Password)
+	if err != nil {
+		return &anErr.CustomError{
+			Message:    "비밀번호 암호화 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	authToken, err := as.consumeToken(ctx, dto.Token, models.AuthTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	// 메일로 받은 토큰을 사용했으므로 이메일 인증도 완료, 잠금은 해제
+	update := bson.M{
+		"$set":   bson.M{"password_hash": hash, "verified": true, "updated_at": time.Now()},
+		"$unset": bson.M{"failed_logins": "", "locked_until": ""},
+	}
+
+	result, err := as.collection.UpdateByID(ctx, authToken.User, update)
+	if err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return &anErr.CustomError{
+			Message:    "User를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	return nil
+}
+
+// recordFailedLogin 로그인 실패 횟수를 늘리고 최대 횟수에 도달하면 계정을 잠금
+func (as *AuthServiceImpl) recordFailedLogin(ctx context.Context, userId primitive.ObjectID, now time.Time) error {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	update := bson.M{"$inc": bson.M{"failed_logins": 1}}
+
+	var user models.User
+	if err := as.collection.FindOneAndUpdate(ctx, bson.M{"_id": userId}, update, opts).Decode(&user); err != nil {
+		return findError("User를 찾을 수 없음", err)
+	}
+
+	if user.FailedLogins < loginMaxAttempts() {
+		return nil
+	}
+
+	lock := bson.M{
+		"$set":   bson.M{"locked_until": now.Add(loginLockoutDuration())},
+		"$unset": bson.M{"failed_logins": ""},
+	}
+	if _, err := as.collection.UpdateByID(ctx, userId, lock); err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// clearFailedLogins 인증에 성공하면 실패 횟수와 잠금을 초기화
+func (as *AuthServiceImpl) clearFailedLogins(ctx context.Context, user *models.User) error {
+	if user.FailedLogins == 0 && user.LockedUntil == nil {
+		return nil
+	}
+
+	update := bson.M{"$unset": bson.M{"failed_logins": "", "locked_until": ""}}
+	if _, err := as.collection.UpdateByID(ctx, user.ID, update); err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	user.FailedLogins = 0
+	user.LockedUntil = nil
+
+	return nil
+}
+
+// findUser ID로 유저 조회
+func (as *AuthServiceImpl) findUser(ctx context.Context, id string) (*models.User, error) {
+	userId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	var user models.User
+	if err := as.collection.FindOne(ctx, bson.M{"_id": userId}).Decode(&user); err != nil {
+		return nil, findError("User를 찾을 수 없음", err)
+	}
+
+	return &user, nil
+}
+
+// issueToken 일회용 토큰을 발급하여 해시만 저장하고 원문을 반환
+func (as *AuthServiceImpl) issueToken(ctx context.Context, userId primitive.ObjectID, purpose string, ttl time.Duration) (string, error) {
+	token, hash, err := utils.NewRandomToken()
+	if err != nil {
+		return "", &anErr.CustomError{
+			Message:    "토큰 생성 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	now := time.Now()
+	authToken := models.AuthToken{
+		ID:        primitive.NewObjectID(),
+		User:      userId,
+		Purpose:   purpose,
+		TokenHash: hash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+
+	if _, err := as.tokenCollection.InsertOne(ctx, authToken); err != nil {
+		return "", &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return token, nil
+}
+
+// consumeToken 만료되지 않은 미사용 토큰을 사용 처리, 같은 토큰은 한 번만 성공
+func (as *AuthServiceImpl) consumeToken(ctx context.Context, token string, purpose string) (*models.AuthToken, error) {
+	now := time.Now()
+	filter := bson.M{
+		"token_hash": utils.HashToken(token),
+		"purpose":    purpose,
+		"used_at":    bson.M{"$exists": false},
+		"expires_at": bson.M{"$gt": now},
+	}
+	update := bson.M{"$set": bson.M{"used_at": now}}
+
+	var authToken models.AuthToken
+	if err := as.tokenCollection.FindOneAndUpdate(ctx, filter, update).Decode(&authToken); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &anErr.CustomError{
+				Message:    "유효하지 않거나 만료된 토큰",
+				StatusCode: http.StatusBadRequest,
+				Err:        err,
+			}
+		}
+		return nil, findError("토큰을 찾을 수 없음", err)
+	}
+
+	return &authToken, nil
+}
+
+// send 메일 발송 실패는 요청을 실패시키지 않고 로그만 남김
+func (as *AuthServiceImpl) send(to string, subject string, body string) {
+	if err := as.mailer.Send(to, subject, body); err != nil {
+		log.Printf("mail to %s failed: %v", to, err)
+	}
+}
+
+// clientLink 클라이언트 페이지 링크, 토큰은 쿼리스트링으로 전달
+func clientLink(path string, token string) string {
+	return fmt.Sprint(strings.TrimRight(os.Getenv("CLIENT_ORIGIN"), "/"), path, "?token=", token)
+}
+
+// loginMaxAttempts 계정을 잠그기 전까지 허용하는 연속 로그인 실패 횟수 (LOGIN_MAX_ATTEMPTS, 기본 5회)
+func loginMaxAttempts() int {
+	if n, err := strconv.Atoi(os.Getenv("LOGIN_MAX_ATTEMPTS")); err == nil && n > 0 {
+		return n
+	}
+	return 5
+}
+
+// loginLockoutDuration 계정 잠금 시간 (LOGIN_LOCKOUT_DURATION, 기본 15분)
+func loginLockoutDuration() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("LOGIN_LOCKOUT_DURATION")); err == nil && d > 0 {
+		return d
+	}
+	return 15 * time.Minute
+}
@@ -0,0 +1,476 @@
+package impl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	anErr "github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 로그인 2단계 토큰 유효 기간, 복구 코드 개수
+const (
+	mfaTokenTTL       = 5 * time.Minute
+	recoveryCodeCount = 10
+)
+
+func (as *AuthServiceImpl) RequiresTOTP(user *models.User) bool {
+	for _, role := range strings.Split(os.Getenv("TOTP_REQUIRED_ROLES"), ",") {
+		if role = strings.TrimSpace(role); role != "" && role == user.RoleName() {
+			return true
+		}
+	}
+	return false
+}
+
+// requiresMFA 로그인에 2단계 인증(등록 포함)이 필요한 유저인지 확인
+func (as *AuthServiceImpl) requiresMFA(user *models.User) bool {
+	return user.HasTOTP() || as.RequiresTOTP(user)
+}
+
+func (as *AuthServiceImpl) Challenge(user *models.User) (*models.MFAChallenge, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return as.challenge(ctx, user)
+}
+
+func (as *AuthServiceImpl) GetChallenge(mfaToken string) (*models.MFAChallenge, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.mfaUser(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := as.challenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge == nil {
+		return nil, &anErr.CustomError{
+			Message:    "2단계 인증이 필요하지 않은 유저",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("mfa not required"),
+		}
+	}
+
+	return challenge, nil
+}
+
+func (as *AuthServiceImpl) VerifyMFA(dto *dto.MFAVerifyDTO) (*models.User, *models.RecoveryCodes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.mfaUser(ctx, dto.MFAToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+
+	if user.IsLocked(now) {
+		return nil, nil, &anErr.CustomError{
+			Message:    "인증 실패가 반복되어 잠긴 계정",
+			StatusCode: http.StatusLocked,
+			Err:        errors.New("account locked"),
+		}
+	}
+
+	invalidErr := &anErr.CustomError{
+		Message:    "인증 코드가 올바르지 않음",
+		StatusCode: http.StatusUnauthorized,
+		Err:        errors.New("invalid mfa code"),
+	}
+
+	var recoveryCodes *models.RecoveryCodes
+
+	switch {
+	case user.HasTOTP():
+		ok, err := as.verifyCode(ctx, user, dto.Code, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			if err := as.recordFailedLogin(ctx, user.ID, now); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, invalidErr
+		}
+
+	// 역할 정책상 필수인데 아직 등록하지 않은 유저는 로그인 중에 등록을 마침
+	case as.RequiresTOTP(user) && user.TOTP != nil && user.TOTP.Secret != "":
+		step, ok := utils.ValidateTOTP(user.TOTP.Secret, dto.Code, now, 0)
+		if !ok {
+			if err := as.recordFailedLogin(ctx, user.ID, now); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, invalidErr
+		}
+		if recoveryCodes, err = as.enableTOTP(ctx, user.ID, step); err != nil {
+			return nil, nil, err
+		}
+
+	default:
+		return nil, nil, &anErr.CustomError{
+			Message:    "2단계 인증이 필요하지 않은 유저",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("mfa not required"),
+		}
+	}
+
+	if err := as.clearFailedLogins(ctx, user); err != nil {
+		return nil, nil, err
+	}
+
+	return user, recoveryCodes, nil
+}
+
+func (as *AuthServiceImpl) EnrollTOTP(userId string) (*models.TOTPEnrollment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasTOTP() {
+		return nil, &anErr.CustomError{
+			Message:    "이미 2단계 인증을 사용 중",
+			StatusCode: http.StatusConflict,
+			Err:        errors.New("totp already enabled"),
+		}
+	}
+
+	return as.pendingEnrollment(ctx, user, true)
+}
+
+func (as *AuthServiceImpl) ConfirmTOTP(userId string, dto *dto.TOTPCodeDTO) (*models.RecoveryCodes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasTOTP() {
+		return nil, &anErr.CustomError{
+			Message:    "이미 2단계 인증을 사용 중",
+			StatusCode: http.StatusConflict,
+			Err:        errors.New("totp already enabled"),
+		}
+	}
+
+	if user.TOTP == nil || user.TOTP.Secret == "" {
+		return nil, &anErr.CustomError{
+			Message:    "2단계 인증 등록을 먼저 시작해야 함",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("totp enrollment not started"),
+		}
+	}
+
+	step, ok := utils.ValidateTOTP(user.TOTP.Secret, dto.Code, time.Now(), 0)
+	if !ok {
+		return nil, &anErr.CustomError{
+			Message:    "인증 코드가 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("invalid totp code"),
+		}
+	}
+
+	return as.enableTOTP(ctx, user.ID, step)
+}
+
+func (as *AuthServiceImpl) RegenerateRecoveryCodes(userId string, dto *dto.TOTPCodeDTO) (*models.RecoveryCodes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.enabledTOTPUser(ctx, userId, dto.Code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := as.collection.UpdateByID(ctx, user.ID, bson.M{"$set": bson.M{"totp.recovery_codes": hashes}}); err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return codes, nil
+}
+
+func (as *AuthServiceImpl) DisableTOTP(userId string, dto *dto.TOTPCodeDTO) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := as.enabledTOTPUser(ctx, userId, dto.Code, true)
+	if err != nil {
+		return err
+	}
+
+	if as.RequiresTOTP(user) {
+		return &anErr.CustomError{
+			Message:    "2단계 인증이 필수인 권한이므로 해제할 수 없음",
+			StatusCode: http.StatusForbidden,
+			Err:        errors.New("totp required for role"),
+		}
+	}
+
+	if _, err := as.collection.UpdateByID(ctx, user.ID, bson.M{"$unset": bson.M{"totp": ""}}); err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// challenge 2단계 인증이 필요하면 mfa_token을 발급, 필요 없으면 nil
+func (as *AuthServiceImpl) challenge(ctx context.Context, user *models.User) (*models.MFAChallenge, error) {
+	if !as.requiresMFA(user) {
+		return nil, nil
+	}
+
+	mfaToken, err := utils.CreateToken(mfaTokenTTL, user.ID.Hex(), mfaTokenSecret())
+	if err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "토큰 생성 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if user.HasTOTP() {
+		return &models.MFAChallenge{Status: models.MFAStatusRequired, MFAToken: mfaToken}, nil
+	}
+
+	enrollment, err := as.pendingEnrollment(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MFAChallenge{Status: models.MFAStatusEnrollmentRequired, MFAToken: mfaToken, Enrollment: enrollment}, nil
+}
+
+// mfaUser mfa_token의 유저, 비활성화된 유저는 거부
+func (as *AuthServiceImpl) mfaUser(ctx context.Context, mfaToken string) (*models.User, error) {
+	sub, err := utils.ValidateToken(mfaToken, mfaTokenSecret())
+	if err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "유효하지 않거나 만료된 토큰",
+			StatusCode: http.StatusUnauthorized,
+			Err:        err,
+		}
+	}
+
+	userId, _ := sub.(string)
+
+	user, err := as.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return nil, &anErr.CustomError{
+			Message:    "비활성화된 유저",
+			StatusCode: http.StatusForbidden,
+			Err:        errors.New("user deactivated"),
+		}
+	}
+
+	return user, nil
+}
+
+// enabledTOTPUser 2단계 인증을 사용 중인 유저를 조회하고 인증 코드를 확인
+func (as *AuthServiceImpl) enabledTOTPUser(ctx context.Context, userId string, code string, allowRecovery bool) (*models.User, error) {
+	user, err := as.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasTOTP() {
+		return nil, &anErr.CustomError{
+			Message:    "2단계 인증을 사용하지 않는 유저",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("totp not enabled"),
+		}
+	}
+
+	ok, err := as.verifyCode(ctx, user, code, allowRecovery)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, &anErr.CustomError{
+			Message:    "인증 코드가 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        errors.New("invalid totp code"),
+		}
+	}
+
+	return user, nil
+}
+
+// verifyCode TOTP 코드 또는 복구 코드를 확인, 사용한 코드는 다시 쓸 수 없도록 기록
+func (as *AuthServiceImpl) verifyCode(ctx context.Context, user *models.User, code string, allowRecovery bool) (bool, error) {
+	if step, ok := utils.ValidateTOTP(user.TOTP.Secret, code, time.Now(), user.TOTP.LastStep); ok {
+		// 같은 구간의 코드를 동시에 두 번 쓰지 못하도록 이전 구간일 때만 갱신
+		filter := bson.M{"_id": user.ID, "totp.last_step": bson.M{"$not": bson.M{"$gte": step}}}
+		result, err := as.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"totp.last_step": step}})
+		if err != nil {
+			return false, &anErr.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+		return result.ModifiedCount == 1, nil
+	}
+
+	if !allowRecovery {
+		return false, nil
+	}
+
+	hash := utils.HashToken(normalizeRecoveryCode(code))
+	result, err := as.collection.UpdateOne(ctx,
+		bson.M{"_id": user.ID, "totp.recovery_codes": hash},
+		bson.M{"$pull": bson.M{"totp.recovery_codes": hash}},
+	)
+	if err != nil {
+		return false, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// pendingEnrollment 등록 대기 중인 비밀키를 반환, 없거나 renew면 새로 생성
+func (as *AuthServiceImpl) pendingEnrollment(ctx context.Context, user *models.User, renew bool) (*models.TOTPEnrollment, error) {
+	var secret string
+	if user.TOTP != nil && !renew {
+		secret = user.TOTP.Secret
+	}
+
+	if secret == "" {
+		var err error
+		if secret, err = utils.NewTOTPSecret(); err != nil {
+			return nil, &anErr.CustomError{
+				Message:    "비밀키 생성 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		pending := models.UserTOTP{Secret: secret}
+		if _, err := as.collection.UpdateByID(ctx, user.ID, bson.M{"$set": bson.M{"totp": pending}}); err != nil {
+			return nil, &anErr.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+	}
+
+	return &models.TOTPEnrollment{
+		Secret:     secret,
+		OtpauthURL: utils.TOTPURI(totpIssuer(), user.Email, secret),
+	}, nil
+}
+
+// enableTOTP 등록을 완료하고 복구 코드를 발급
+func (as *AuthServiceImpl) enableTOTP(ctx context.Context, userId primitive.ObjectID, step int64) (*models.RecoveryCodes, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"totp.enabled":        true,
+		"totp.confirmed_at":   time.Now(),
+		"totp.recovery_codes": hashes,
+		"totp.last_step":      step,
+	}}
+
+	if _, err := as.collection.UpdateByID(ctx, userId, update); err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return codes, nil
+}
+
+// newRecoveryCodes 복구 코드와 저장용 해시 생성 (xxxx-xxxx-xxxx-xxxx)
+func newRecoveryCodes() (*models.RecoveryCodes, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, &anErr.CustomError{
+				Message:    "복구 코드 생성 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes = append(codes, strings.Join([]string{raw[0:4], raw[4:8], raw[8:12], raw[12:16]}, "-"))
+		hashes = append(hashes, utils.HashToken(raw))
+	}
+
+	return &models.RecoveryCodes{Codes: codes}, hashes, nil
+}
+
+// normalizeRecoveryCode 입력한 복구 코드에서 구분자와 대소문자 차이를 제거
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+// mfaTokenSecret 로그인 2단계 토큰 서명 키 (MFA_TOKEN_JWT_SECRET), access 토큰으로 쓸 수 없도록 키를 분리
+func mfaTokenSecret() string {
+	if secret := os.Getenv("MFA_TOKEN_JWT_SECRET"); secret != "" {
+		return secret
+	}
+	return os.Getenv("ACCESS_TOKEN_JWT_SECRET") + ":mfa"
+}
+
+// totpIssuer 인증 앱에 표시할 서비스 이름 (TOTP_ISSUER, 기본 All Note)
+func totpIssuer() string {
+	if issuer := os.Getenv("TOTP_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return "All Note"
+}
@@ -0,0 +1,437 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 이름 유사도가 이 값 이상이면 중복 의심 지원자로 봄
+const candidateNameThreshold = 0.85
+
+// 중복 검사 시 이름 비교 대상으로 조회할 최대 지원자 수
+const candidateScanLimit = 500
+
+type CandidateServiceImpl struct {
+	collection               *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+}
+
+func NewCandidateServiceImpl(collection *mongo.Collection, jobApplicationCollection *mongo.Collection) services.CandidateService {
+	return &CandidateServiceImpl{collection, jobApplicationCollection}
+}
+
+func (cs *CandidateServiceImpl) GetAllCandidate() ([]models.Candidate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var candidates []models.Candidate
+
+	results, err := cs.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &candidates); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return candidates, nil
+}
+
+func (cs *CandidateServiceImpl) GetCandidateProfile(id string) (*models.CandidateProfile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	return cs.candidateProfile(ctx, candidateId)
+}
+
+func (cs *CandidateServiceImpl) GetCandidateDuplicates(id string) ([]models.CandidateMatch, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	candidate, err := cs.findCandidate(ctx, candidateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.findDuplicates(ctx, candidate)
+}
+
+func (cs *CandidateServiceImpl) CreateCandidate(dto *dto.CandidateCreateDTO) (*models.Candidate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if utils.NormalizeName(dto.Name) == "" {
+		return nil, &errors.CustomError{
+			Message:    "지원자 이름이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("candidate name is required"),
+		}
+	}
+
+	candidate := &models.Candidate{
+		ID:        primitive.NewObjectID(),
+		Name:      dto.Name,
+		Email:     utils.NormalizeEmail(dto.Email),
+		Phone:     dto.Phone,
+		Links:     dto.Links,
+		NameKey:   utils.NormalizeName(dto.Name),
+		PhoneKey:  utils.NormalizePhone(dto.Phone),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	duplicates, err := cs.findDuplicates(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cs.collection.InsertOne(ctx, candidate); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	candidate.Duplicates = duplicates
+
+	return candidate, nil
+}
+
+func (cs *CandidateServiceImpl) UpdateCandidate(ctx context.Context, id string, dto *dto.CandidateUpdateDTO) (*models.Candidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	candidate := bson.M{
+		"updated_at": time.Now(),
+	}
+
+	if utils.NormalizeName(dto.Name) != "" {
+		candidate["name"] = dto.Name
+		candidate["name_key"] = utils.NormalizeName(dto.Name)
+	}
+
+	if dto.Email != "" {
+		candidate["email"] = utils.NormalizeEmail(dto.Email)
+	}
+
+	if dto.Phone != "" {
+		candidate["phone"] = dto.Phone
+		candidate["phone_key"] = utils.NormalizePhone(dto.Phone)
+	}
+
+	if dto.Links != nil {
+		candidate["links"] = dto.Links
+	}
+
+	filter := versionFilter(ctx, bson.M{"_id": candidateId})
+	update := bumpVersion(bson.M{"$set": candidate})
+
+	var updatedCandidate models.Candidate
+	if err := cs.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedCandidate); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, cs.collection, candidateId, findError("지원자를 찾을 수 없음", err))
+		}
+		return nil, findError("지원자를 찾을 수 없음", err)
+	}
+
+	return &updatedCandidate, nil
+}
+
+func (cs *CandidateServiceImpl) MergeCandidate(id string, dto *dto.CandidateMergeDTO) (*models.CandidateProfile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	targetId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	sourceId, err := utils.ConvertToObjectId(dto.Source)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	if targetId == sourceId {
+		return nil, &errors.CustomError{
+			Message:    "같은 지원자는 병합할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("cannot merge candidate %s into itself", targetId.Hex()),
+		}
+	}
+
+	target, err := cs.findCandidate(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := cs.findCandidate(ctx, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 대상 지원자 값을 우선하고 비어 있는 값만 source로 채움
+	merged := bson.M{
+		"links":      mergeLinks(target.Links, source.Links),
+		"updated_at": time.Now(),
+	}
+	if target.Email == "" && source.Email != "" {
+		merged["email"] = source.Email
+	}
+	if target.Phone == "" && source.Phone != "" {
+		merged["phone"] = source.Phone
+		merged["phone_key"] = source.PhoneKey
+	}
+
+	session, err := cs.collection.Database().Client().StartSession()
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if _, err := cs.jobApplicationCollection.UpdateMany(sessCtx, bson.M{"candidate": sourceId}, bumpVersion(bson.M{"$set": bson.M{"candidate": targetId}})); err != nil {
+			return nil, err
+		}
+
+		if _, err := cs.collection.UpdateOne(sessCtx, bson.M{"_id": targetId}, bumpVersion(bson.M{"$set": merged})); err != nil {
+			return nil, err
+		}
+
+		return cs.collection.DeleteOne(sessCtx, bson.M{"_id": sourceId})
+	})
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return cs.candidateProfile(ctx, targetId)
+}
+
+func (cs *CandidateServiceImpl) DeleteCandidate(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("Candidate", err)
+	}
+
+	count, err := cs.jobApplicationCollection.CountDocuments(ctx, bson.M{"candidate": candidateId})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count > 0 {
+		return &errors.CustomError{
+			Message:    "지원 이력이 있는 지원자는 삭제할 수 없음",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("candidate %s has %d job applications", candidateId.Hex(), count),
+		}
+	}
+
+	result, err := cs.collection.DeleteOne(ctx, bson.M{"_id": candidateId})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return &errors.CustomError{
+			Message:    "지원자를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	return nil
+}
+
+// findCandidate 지원자를 조회
+func (cs *CandidateServiceImpl) findCandidate(ctx context.Context, candidateId primitive.ObjectID) (*models.Candidate, error) {
+	var candidate models.Candidate
+
+	if err := cs.collection.FindOne(ctx, bson.M{"_id": candidateId}).Decode(&candidate); err != nil {
+		return nil, findError("지원자를 찾을 수 없음", err)
+	}
+
+	return &candidate, nil
+}
+
+// candidateProfile 지원자와 최신순 지원 이력을 조회
+func (cs *CandidateServiceImpl) candidateProfile(ctx context.Context, candidateId primitive.ObjectID) (*models.CandidateProfile, error) {
+	candidate, err := cs.findCandidate(ctx, candidateId)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.CandidateProfile{Candidate: *candidate, Applications: []models.JobApplication{}}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	results, err := cs.jobApplicationCollection.Find(ctx, bson.M{"candidate": candidateId, "deleted_at": nil}, opts)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &profile.Applications); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return profile, nil
+}
+
+// findDuplicates 이메일/전화번호가 같거나 이름이 비슷한 지원자를 유사도 순으로 조회
+// 이름은 첫 글자가 같은 지원자만 비교
+func (cs *CandidateServiceImpl) findDuplicates(ctx context.Context, candidate *models.Candidate) ([]models.CandidateMatch, error) {
+	or := bson.A{}
+	if candidate.Email != "" {
+		or = append(or, bson.M{"email": candidate.Email})
+	}
+	if len(candidate.PhoneKey) >= 7 {
+		or = append(or, bson.M{"phone_key": candidate.PhoneKey})
+	}
+	if first := []rune(candidate.NameKey); len(first) > 0 {
+		or = append(or, bson.M{"name_key": bson.M{"$regex": "^" + regexp.QuoteMeta(string(first[0]))}})
+	}
+
+	filter := bson.M{"_id": bson.M{"$ne": candidate.ID}, "$or": or}
+
+	var candidates []models.Candidate
+
+	results, err := cs.collection.Find(ctx, filter, options.Find().SetLimit(candidateScanLimit))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &candidates); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	matches := []models.CandidateMatch{}
+	for _, other := range candidates {
+		if match, ok := matchCandidate(candidate, &other); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches, nil
+}
+
+// matchCandidate 두 지원자의 일치 근거와 점수를 계산
+func matchCandidate(candidate *models.Candidate, other *models.Candidate) (models.CandidateMatch, bool) {
+	match := models.CandidateMatch{Candidate: *other, Reasons: []string{}}
+
+	if candidate.Email != "" && candidate.Email == other.Email {
+		match.Reasons = append(match.Reasons, "email")
+		match.Score = 1
+	}
+
+	if len(candidate.PhoneKey) >= 7 && candidate.PhoneKey == other.PhoneKey {
+		match.Reasons = append(match.Reasons, "phone")
+		match.Score = max(match.Score, 0.9)
+	}
+
+	if similarity := utils.Similarity(candidate.NameKey, other.NameKey); similarity >= candidateNameThreshold {
+		match.Reasons = append(match.Reasons, "name")
+		match.Score = max(match.Score, similarity)
+	}
+
+	return match, len(match.Reasons) > 0
+}
+
+// mergeLinks 두 링크 목록을 순서를 유지하며 중복 없이 합침
+func mergeLinks(target []string, source []string) []string {
+	links := []string{}
+	seen := map[string]bool{}
+
+	for _, link := range append(append([]string{}, target...), source...) {
+		if !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
@@ -0,0 +1,445 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 멘션 알림 메시지
+const mentionMessage = "댓글에서 회원님을 멘션함"
+
+type CommentServiceImpl struct {
+	collection               *mongo.Collection
+	notificationCollection   *mongo.Collection
+	userCollection           *mongo.Collection
+	projectCollection        *mongo.Collection
+	taskCollection           *mongo.Collection
+	todoCollection           *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+}
+
+func NewCommentServiceImpl(collection *mongo.Collection, notificationCollection *mongo.Collection, userCollection *mongo.Collection, projectCollection *mongo.Collection, taskCollection *mongo.Collection, todoCollection *mongo.Collection, jobApplicationCollection *mongo.Collection) services.CommentService {
+	return &CommentServiceImpl{collection, notificationCollection, userCollection, projectCollection, taskCollection, todoCollection, jobApplicationCollection}
+}
+
+func (cs *CommentServiceImpl) GetCommentByTarget(query *dto.CommentQueryDTO, userId string) ([]models.Comment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	targetId, err := cs.authorizeTarget(ctx, query.TargetType, query.Target, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []models.Comment
+
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "target_type", Value: query.TargetType}, {Key: "target", Value: targetId}}}}
+
+	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "users"},
+		{Key: "localField", Value: "author"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "author_info"},
+	}}}
+
+	sortStage := bson.D{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}}}}
+
+	pipeline := mongo.Pipeline{matchStage, lookupUserStage, sortStage}
+
+	results, err := cs.collection.Aggregate(ctx, pipeline)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &comments); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return comments, nil
+}
+
+func (cs *CommentServiceImpl) CreateComment(dto *dto.CommentCreateDTO, userId string) (*models.Comment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if dto.Content == "" {
+		return nil, &errors.CustomError{
+			Message:    "댓글 내용이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("content is required"),
+		}
+	}
+
+	targetId, err := cs.authorizeTarget(ctx, dto.TargetType, dto.Target, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := cs.resolveMentions(ctx, dto.Content, dto.TargetType, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		ID:         primitive.NewObjectID(),
+		TargetType: dto.TargetType,
+		Target:     targetId,
+		Author:     actorId,
+		Content:    dto.Content,
+		Mentions:   mentions,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+
+	if _, err := cs.collection.InsertOne(ctx, comment); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := cs.notifyMentions(ctx, comment, mentions); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (cs *CommentServiceImpl) UpdateComment(ctx context.Context, id string, dto *dto.CommentUpdateDTO, userId string) (*models.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	commentId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Comment", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if dto.Content == "" {
+		return nil, &errors.CustomError{
+			Message:    "댓글 내용이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("content is required"),
+		}
+	}
+
+	current, err := cs.authorizeAuthor(ctx, commentId, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := cs.resolveMentions(ctx, dto.Content, current.TargetType, current.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	filter := versionFilter(ctx, bson.M{"_id": commentId})
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"content":    dto.Content,
+		"mentions":   mentions,
+		"edited_at":  now,
+		"updated_at": now,
+	}})
+
+	result := cs.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, cs.collection, commentId, &errors.CustomError{
+				Message:    "댓글을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        result.Err(),
+			})
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        result.Err(),
+		}
+	}
+
+	var updatedComment *models.Comment
+	if err := result.Decode(&updatedComment); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "결과 디코딩 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	// 수정으로 새로 멘션된 유저에게만 알림
+	previous := map[primitive.ObjectID]bool{}
+	for _, mention := range current.Mentions {
+		previous[mention] = true
+	}
+
+	added := []primitive.ObjectID{}
+	for _, mention := range mentions {
+		if !previous[mention] {
+			added = append(added, mention)
+		}
+	}
+
+	if err := cs.notifyMentions(ctx, updatedComment, added); err != nil {
+		return nil, err
+	}
+
+	return updatedComment, nil
+}
+
+func (cs *CommentServiceImpl) DeleteComment(ctx context.Context, id string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	commentId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("Comment", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	if _, err := cs.authorizeAuthor(ctx, commentId, actorId); err != nil {
+		return err
+	}
+
+	result, err := cs.collection.DeleteOne(ctx, versionFilter(ctx, bson.M{"_id": commentId}))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return matchError(ctx, cs.collection, commentId, &errors.CustomError{
+			Message:    "댓글을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	// 삭제된 댓글의 멘션 알림도 제거
+	if _, err := cs.notificationCollection.DeleteMany(ctx, bson.M{"comment": commentId}); err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// authorizeTarget 댓글 대상이 존재하고 유저가 조회할 수 있는지 확인
+// Project Task와 Project에 연결된 Todo는 Project 조회 권한이 필요
+func (cs *CommentServiceImpl) authorizeTarget(ctx context.Context, targetType string, target string, userId primitive.ObjectID) (primitive.ObjectID, error) {
+	if !models.IsValidCommentTarget(targetType) {
+		return primitive.NilObjectID, &errors.CustomError{
+			Message:    "유효하지 않은 댓글 대상",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid target_type: %s", targetType),
+		}
+	}
+
+	targetId, err := utils.ConvertToObjectId(target)
+	if err != nil {
+		return primitive.NilObjectID, utils.ConvertError("Target", err)
+	}
+
+	switch targetType {
+	case models.CommentTargetProjectTask:
+		var task models.ProjectTask
+		if err := cs.taskCollection.FindOne(ctx, bson.M{"_id": targetId, "deleted_at": nil}).Decode(&task); err != nil {
+			return primitive.NilObjectID, findError("Project Task를 찾을 수 없음", err)
+		}
+		if _, err := checkProjectAccess(ctx, cs.projectCollection, task.Project, userId, projectRead); err != nil {
+			return primitive.NilObjectID, err
+		}
+
+	case models.CommentTargetTodo:
+		var todo models.Todo
+		if err := cs.todoCollection.FindOne(ctx, bson.M{"_id": targetId, "deleted_at": nil}).Decode(&todo); err != nil {
+			return primitive.NilObjectID, findError("TODO를 찾을 수 없음", err)
+		}
+		if !todo.Project.IsZero() {
+			if _, err := checkProjectAccess(ctx, cs.projectCollection, todo.Project, userId, projectRead); err != nil {
+				return primitive.NilObjectID, err
+			}
+		}
+
+	case models.CommentTargetJobApplication:
+		if err := cs.jobApplicationCollection.FindOne(ctx, bson.M{"_id": targetId, "deleted_at": nil}).Err(); err != nil {
+			return primitive.NilObjectID, findError("Job Application을 찾을 수 없음", err)
+		}
+	}
+
+	return targetId, nil
+}
+
+// authorizeAuthor 댓글을 조회하여 작성자인지 확인
+func (cs *CommentServiceImpl) authorizeAuthor(ctx context.Context, commentId primitive.ObjectID, userId primitive.ObjectID) (*models.Comment, error) {
+	var comment models.Comment
+
+	if err := cs.collection.FindOne(ctx, bson.M{"_id": commentId}).Decode(&comment); err != nil {
+		return nil, findError("댓글을 찾을 수 없음", err)
+	}
+
+	if comment.Author != userId {
+		return nil, &errors.CustomError{
+			Message:    "댓글 작성자만 수정/삭제할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s is not the author of comment %s", userId.Hex(), commentId.Hex()),
+		}
+	}
+
+	return &comment, nil
+}
+
+// resolveMentions 댓글 내용의 @이름을 유저 이름 또는 이메일 아이디와 비교하여 유저 ID로 변환
+// 댓글 대상을 조회할 수 없는 유저는 멘션하지 않음
+func (cs *CommentServiceImpl) resolveMentions(ctx context.Context, content string, targetType string, target primitive.ObjectID) ([]primitive.ObjectID, error) {
+	names := utils.ParseMentions(content)
+	if len(names) == 0 {
+		return []primitive.ObjectID{}, nil
+	}
+
+	emails := bson.A{}
+	for _, name := range names {
+		emails = append(emails, primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name) + "@", Options: "i"})
+	}
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"user_name": bson.M{"$in": names}},
+		bson.M{"email": bson.M{"$in": emails}},
+	}}
+
+	ids, err := cs.userCollection.Distinct(ctx, "_id", filter)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	mentions := []primitive.ObjectID{}
+	for _, id := range ids {
+		userId, ok := id.(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+
+		if _, err := cs.authorizeTarget(ctx, targetType, target.Hex(), userId); err != nil {
+			if customErr, ok := err.(*errors.CustomError); ok && customErr.StatusCode == http.StatusForbidden {
+				continue
+			}
+			return nil, err
+		}
+
+		mentions = append(mentions, userId)
+	}
+
+	return mentions, nil
+}
+
+// notifyMentions 멘션된 유저에게 알림 생성 (작성자 본인 제외)
+// 알림에는 댓글 내용을 복사하지 않고 대상과 댓글 ID만 남김, 내용은 댓글 조회 권한으로 확인
+func (cs *CommentServiceImpl) notifyMentions(ctx context.Context, comment *models.Comment, mentions []primitive.ObjectID) error {
+	notifications := []interface{}{}
+
+	for _, userId := range mentions {
+		if userId == comment.Author {
+			continue
+		}
+		notifications = append(notifications, models.Notification{
+			ID:         primitive.NewObjectID(),
+			User:       userId,
+			Type:       models.NotificationMention,
+			Actor:      comment.Author,
+			TargetType: comment.TargetType,
+			Target:     comment.Target,
+			Comment:    comment.ID,
+			Message:    mentionMessage,
+			CreatedAt:  time.Now(),
+		})
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	if _, err := cs.notificationCollection.InsertMany(ctx, notifications); err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// commentCountStages 목록 조회 aggregation에 댓글 수(comment_count)를 추가하는 stage
+func commentCountStages(targetType string) []bson.D {
+	lookupCommentStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "comments"},
+		{Key: "let", Value: bson.D{{Key: "target", Value: "$_id"}}},
+		{Key: "pipeline", Value: bson.A{
+			bson.D{{Key: "$match", Value: bson.D{
+				{Key: "target_type", Value: targetType},
+				{Key: "$expr", Value: bson.D{{Key: "$eq", Value: bson.A{"$target", "$$target"}}}},
+			}}},
+			bson.D{{Key: "$count", Value: "count"}},
+		}},
+		{Key: "as", Value: "comment_count"},
+	}}}
+
+	addCountStage := bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "comment_count", Value: bson.D{{Key: "$ifNull", Value: bson.A{bson.D{{Key: "$first", Value: "$comment_count.count"}}, 0}}}},
+	}}}
+
+	return []bson.D{lookupCommentStage, addCountStage}
+}
@@ -18,11 +18,15 @@ import (
 )
 
 type JobApplicationServiceImpl struct {
-	collection *mongo.Collection
+	collection          *mongo.Collection
+	pipelineCollection  *mongo.Collection
+	meetingCollection   *mongo.Collection
+	candidateCollection *mongo.Collection
+	auditCollection     *mongo.Collection
 }
 
-func NewJobApplicationServiceImpl(collection *mongo.Collection) services.JobApplicationService {
-	return &JobApplicationServiceImpl{collection}
+func NewJobApplicationServiceImpl(collection *mongo.Collection, pipelineCollection *mongo.Collection, meetingCollection *mongo.Collection, candidateCollection *mongo.Collection, auditCollection *mongo.Collection) services.JobApplicationService {
+	return &JobApplicationServiceImpl{collection, pipelineCollection, meetingCollection, candidateCollection, auditCollection}
 }
 
 func (js *JobApplicationServiceImpl) GetAllJobApplication() ([]models.JobApplication, error) {
@@ -45,7 +49,8 @@ func (js *JobApplicationServiceImpl) GetAllJobApplication() ([]models.JobApplica
 		{Key: "as", Value: "department_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{lookupUserStage, lookupDepartmentStage}
+	pipeline := mongo.Pipeline{notDeletedStage, lookupUserStage, lookupDepartmentStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetJobApplication)...)
 
 	results, err := js.collection.Aggregate(ctx, pipeline)
 
@@ -81,7 +86,7 @@ func (js *JobApplicationServiceImpl) GetJobApplication(id string) (*models.JobAp
 
 	var jobApplication *models.JobApplication
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: jobApplicationId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: jobApplicationId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -98,6 +103,7 @@ func (js *JobApplicationServiceImpl) GetJobApplication(id string) (*models.JobAp
 	}}}
 
 	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupDepartmentStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetJobApplication)...)
 
 	result, err := js.collection.Aggregate(ctx, pipeline)
 
@@ -135,7 +141,7 @@ func (js *JobApplicationServiceImpl) GetJobApplicationByManager(id string) ([]mo
 
 	var jobApplications []models.JobApplication
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "manager", Value: managerId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "manager", Value: managerId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -152,6 +158,7 @@ func (js *JobApplicationServiceImpl) GetJobApplicationByManager(id string) ([]mo
 	}}}
 
 	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupDepartmentStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetJobApplication)...)
 
 	results, err := js.collection.Aggregate(ctx, pipeline)
 
@@ -176,8 +183,8 @@ func (js *JobApplicationServiceImpl) GetJobApplicationByManager(id string) ([]mo
 	return jobApplications, nil
 }
 
-func (js *JobApplicationServiceImpl) CreateJobApplication(dto *dto.JobApplicationCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (js *JobApplicationServiceImpl) CreateJobApplication(ctx context.Context, dto *dto.JobApplicationCreateDTO) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
@@ -192,6 +199,7 @@ func (js *JobApplicationServiceImpl) CreateJobApplication(dto *dto.JobApplicatio
 		StartDt:       dto.StartDt,
 		EndDt:         dto.EndDt,
 		Status:        dto.Status,
+		Attachments:   convertAttachments(dto.Attachments),
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
@@ -206,6 +214,40 @@ func (js *JobApplicationServiceImpl) CreateJobApplication(dto *dto.JobApplicatio
 		return utils.ConvertError("Department", err)
 	}
 
+	if dto.Candidate != "" {
+		candidate, err := js.findCandidate(ctx, dto.Candidate)
+		if err != nil {
+			return err
+		}
+		jobApplication.Candidate = candidate.ID
+		if jobApplication.ApplicantName == "" {
+			jobApplication.ApplicantName = candidate.Name
+		}
+	}
+
+	pipeline, err := departmentPipeline(ctx, js.pipelineCollection, jobApplication.Department)
+	if err != nil {
+		return err
+	}
+
+	// 부서 파이프라인이 있으면 첫 단계에서 시작하고 정의된 단계만 허용
+	if pipeline != nil {
+		if jobApplication.Stage == "" {
+			jobApplication.Stage = pipeline.Stages[0].Key
+		}
+		if pipeline.StageIndex(jobApplication.Stage) < 0 {
+			return &errors.CustomError{
+				Message:    "채용 파이프라인에 없는 단계",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("unknown stage: %s", jobApplication.Stage),
+			}
+		}
+	}
+
+	if jobApplication.Stage != "" {
+		jobApplication.StageHistory = []models.StageChange{{Stage: jobApplication.Stage, MovedAt: jobApplication.CreatedAt}}
+	}
+
 	fmt.Printf("jobApplication: %+v", jobApplication)
 
 	_, err = js.collection.InsertOne(ctx, jobApplication)
@@ -218,11 +260,15 @@ func (js *JobApplicationServiceImpl) CreateJobApplication(dto *dto.JobApplicatio
 		}
 	}
 
+	if err := recordChange(ctx, js.auditCollection, js.collection, models.AuditResourceJobApplication, jobApplication.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.JobApplicationUpdateDTO) (*models.JobApplication, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (js *JobApplicationServiceImpl) UpdateJobApplication(ctx context.Context, id string, dto *dto.JobApplicationUpdateDTO, userId string) (*models.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	jobApplicationId, err := utils.ConvertToObjectId(id)
@@ -230,6 +276,16 @@ func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.Jo
 		return nil, utils.ConvertError("JobApplication", err)
 	}
 
+	currentUserId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	current, err := js.findJobApplication(ctx, jobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
 	jobApplication := bson.M{
 		"updated_at": time.Now(),
 	}
@@ -246,10 +302,6 @@ func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.Jo
 		jobApplication["task"] = dto.Task
 	}
 
-	if dto.Stage != "" {
-		jobApplication["stage"] = dto.Stage
-	}
-
 	if dto.Location != "" {
 		jobApplication["location"] = dto.Location
 	}
@@ -262,6 +314,10 @@ func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.Jo
 		jobApplication["start_dt"] = dto.StartDt
 	}
 
+	if dto.Attachments != nil {
+		jobApplication["attachments"] = convertAttachments(dto.Attachments)
+	}
+
 	if !dto.EndDt.IsZero() {
 		jobApplication["end_dt"] = dto.EndDt
 	}
@@ -278,19 +334,44 @@ func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.Jo
 		}
 	}
 
-	filter := bson.M{"_id": jobApplicationId}
-	update := bson.M{"$set": jobApplication}
+	if dto.Candidate != "" {
+		candidate, err := js.findCandidate(ctx, dto.Candidate)
+		if err != nil {
+			return nil, err
+		}
+		jobApplication["candidate"] = candidate.ID
+	}
+
+	before := auditSnapshot(ctx, js.collection, jobApplicationId)
+
+	filter := versionFilter(ctx, bson.M{"_id": jobApplicationId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": jobApplication})
+
+	if dto.Stage != "" && dto.Stage != current.Stage {
+		departmentId := current.Department
+		if department, ok := jobApplication["department"].(primitive.ObjectID); ok {
+			departmentId = department
+		}
+
+		changes, err := js.stageChanges(ctx, current, departmentId, dto.Stage, currentUserId)
+		if err != nil {
+			return nil, err
+		}
+
+		jobApplication["stage"] = dto.Stage
+		update["$push"] = bson.M{"stage_history": bson.M{"$each": changes}}
+	}
 
 	fmt.Printf("jobApplication: %+v", jobApplication)
 
 	result := js.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, js.collection, jobApplicationId, &errors.CustomError{
 				Message:    "JobApplication을 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -308,21 +389,92 @@ func (js *JobApplicationServiceImpl) UpdateJobApplication(id string, dto *dto.Jo
 		}
 	}
 
+	if err := recordChange(ctx, js.auditCollection, js.collection, models.AuditResourceJobApplication, jobApplicationId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedJobApplication, nil
 }
 
-func (js *JobApplicationServiceImpl) DeleteJobApplication(id string) error {
+func (js *JobApplicationServiceImpl) GetJobApplicationStageDurations(id string) ([]models.StageDuration, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	jobApplicationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("JobApplication", err)
+	}
+
+	jobApplication, err := js.findJobApplication(ctx, jobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobApplication.StageDurations(time.Now()), nil
+}
+
+func (js *JobApplicationServiceImpl) GetRecruitingFunnel(query *dto.RecruitingFunnelQueryDTO) (*models.RecruitingFunnel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, &errors.CustomError{
+			Message:    "조회 기간(from, to)이 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid funnel window: %s ~ %s", query.From, query.To),
+		}
+	}
+
+	match := bson.D{{Key: "deleted_at", Value: nil}}
+	if period := periodFilter(query.From, query.To); len(period) > 0 {
+		match = append(match, bson.E{Key: "start_dt", Value: period})
+	}
+
+	// 부서를 지정하면 부서 파이프라인의 단계 순서로 전환율을 계산
+	var stageOrder []string
+	if query.Department != "" {
+		departmentId, err := utils.ConvertToObjectId(query.Department)
+		if err != nil {
+			return nil, utils.ConvertError("Department", err)
+		}
+		match = append(match, bson.E{Key: "department", Value: departmentId})
+
+		pipeline, err := departmentPipeline(ctx, js.pipelineCollection, departmentId)
+		if err != nil {
+			return nil, err
+		}
+		if pipeline != nil {
+			for _, stage := range pipeline.Stages {
+				stageOrder = append(stageOrder, stage.Key)
+			}
+		}
+	}
+
+	funnel, err := recruitingFunnel(ctx, js.collection, match, stageOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	funnel.From = funnelPeriod(query.From)
+	funnel.To = funnelPeriod(query.To)
+
+	return funnel, nil
+}
+
+func (js *JobApplicationServiceImpl) DeleteJobApplication(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
 	jobApplicationId, err := utils.ConvertToObjectId(id)
 	if err != nil {
 		return utils.ConvertError("JobApplication", err)
 	}
 
-	filter := bson.M{"_id": jobApplicationId}
+	before := auditSnapshot(ctx, js.collection, jobApplicationId)
 
-	result, err := js.collection.DeleteOne(ctx, filter)
+	filter := versionFilter(ctx, bson.M{"_id": jobApplicationId})
+
+	result, err := softDelete(ctx, js.collection, filter, nil)
 	if err != nil {
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -331,13 +483,297 @@ func (js *JobApplicationServiceImpl) DeleteJobApplication(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
-		return &errors.CustomError{
+	if result.MatchedCount == 0 {
+		return matchError(ctx, js.collection, jobApplicationId, &errors.CustomError{
 			Message:    "JobApplication을 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
-		}
+		})
+	}
+
+	if err := recordAudit(ctx, js.auditCollection, models.AuditResourceJobApplication, models.AuditActionDelete, jobApplicationId, before, auditSnapshot(ctx, js.collection, jobApplicationId)); err != nil {
+		return err
 	}
 
 	return nil
 }
+
+func (js *JobApplicationServiceImpl) GetJobApplicationInterviews(id string) ([]models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobApplicationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("JobApplication", err)
+	}
+
+	if _, err := js.findJobApplication(ctx, jobApplicationId); err != nil {
+		return nil, err
+	}
+
+	var interviews []models.Meeting
+
+	filter := bson.M{"interview.job_application": jobApplicationId, "deleted_at": nil}
+	opts := options.Find().SetSort(bson.D{{Key: "start_dt", Value: 1}})
+
+	results, err := js.meetingCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &interviews); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	for i := range interviews {
+		interviews[i].SetRSVPSummary()
+	}
+
+	return interviews, nil
+}
+
+func (js *JobApplicationServiceImpl) ScheduleInterview(ctx context.Context, id string, dto *dto.InterviewCreateDTO, userId string) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	jobApplicationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("JobApplication", err)
+	}
+
+	createdBy, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if len(dto.Interviewers) == 0 {
+		return nil, &errors.CustomError{
+			Message:    "면접관이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("interviewers are required"),
+		}
+	}
+
+	if dto.StartDt.IsZero() || (!dto.EndDt.IsZero() && !dto.EndDt.After(dto.StartDt)) {
+		return nil, &errors.CustomError{
+			Message:    "면접 일시가 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid interview schedule: %s ~ %s", dto.StartDt, dto.EndDt),
+		}
+	}
+
+	interviewerIds, err := utils.ConvertStringIDsToObjectIDs(dto.Interviewers)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	jobApplication, err := js.findJobApplication(ctx, jobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	title := dto.Title
+	if title == "" {
+		title = interviewTitle(jobApplication)
+	}
+
+	meeting := &models.Meeting{
+		ID:          primitive.NewObjectID(),
+		Title:       title,
+		Description: interviewDescription(jobApplication, dto.Notes),
+		StartDt:     dto.StartDt,
+		EndDt:       dto.EndDt,
+		Location:    dto.Location,
+		User:        createdBy,
+		Interview: &models.Interview{
+			JobApplication: jobApplicationId,
+			Outcome:        models.InterviewOutcomePending,
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	meeting.Participants = make([]models.Participant, len(interviewerIds))
+	for i, interviewerId := range interviewerIds {
+		meeting.Participants[i] = models.Participant{User: interviewerId, Status: models.RSVPPending}
+	}
+
+	if _, err := js.meetingCollection.InsertOne(ctx, meeting); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	meeting.SetRSVPSummary()
+
+	if err := recordChange(ctx, js.auditCollection, js.meetingCollection, models.AuditResourceMeeting, meeting.ID, nil); err != nil {
+		return nil, err
+	}
+
+	return meeting, nil
+}
+
+func (js *JobApplicationServiceImpl) UpdateInterviewOutcome(ctx context.Context, id string, meetingId string, dto *dto.InterviewOutcomeDTO, userId string) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	jobApplicationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("JobApplication", err)
+	}
+
+	interviewId, err := utils.ConvertToObjectId(meetingId)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	decidedBy, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if !models.IsValidInterviewOutcome(dto.Outcome) {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 면접 결과",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid interview outcome: %s", dto.Outcome),
+		}
+	}
+
+	now := time.Now()
+
+	before := auditSnapshot(ctx, js.meetingCollection, interviewId)
+
+	filter := versionFilter(ctx, bson.M{"_id": interviewId, "interview.job_application": jobApplicationId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"interview.outcome":    dto.Outcome,
+		"interview.note":       dto.Note,
+		"interview.decided_by": decidedBy,
+		"interview.decided_at": now,
+		"updated_at":           now,
+	}})
+
+	var interview models.Meeting
+	if err := js.meetingCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&interview); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, js.meetingCollection, interviewId, findError("면접을 찾을 수 없음", err))
+		}
+		return nil, findError("면접을 찾을 수 없음", err)
+	}
+
+	interview.SetRSVPSummary()
+
+	if err := recordChange(ctx, js.auditCollection, js.meetingCollection, models.AuditResourceMeeting, interviewId, before); err != nil {
+		return nil, err
+	}
+
+	return &interview, nil
+}
+
+// interviewTitle 제목을 입력하지 않은 면접 Meeting의 기본 제목
+func interviewTitle(jobApplication *models.JobApplication) string {
+	return fmt.Sprintf("면접: %s (%s)", jobApplication.ApplicantName, jobApplication.Position)
+}
+
+// interviewDescription 면접 Meeting 설명에 들어갈 지원자 정보
+func interviewDescription(jobApplication *models.JobApplication, notes string) string {
+	description := fmt.Sprintf("지원자: %s\n포지션: %s", jobApplication.ApplicantName, jobApplication.Position)
+	if jobApplication.Task != "" {
+		description += fmt.Sprintf("\n직무: %s", jobApplication.Task)
+	}
+	if jobApplication.Stage != "" {
+		description += fmt.Sprintf("\n채용 단계: %s", jobApplication.Stage)
+	}
+	description += fmt.Sprintf("\n지원서: %s", jobApplication.ID.Hex())
+	if notes != "" {
+		description += "\n\n" + notes
+	}
+	return description
+}
+
+// findJobApplication JobApplication을 조회
+func (js *JobApplicationServiceImpl) findJobApplication(ctx context.Context, jobApplicationId primitive.ObjectID) (*models.JobApplication, error) {
+	var jobApplication models.JobApplication
+
+	if err := js.collection.FindOne(ctx, bson.M{"_id": jobApplicationId, "deleted_at": nil}).Decode(&jobApplication); err != nil {
+		return nil, findError("JobApplication을 찾을 수 없음", err)
+	}
+
+	return &jobApplication, nil
+}
+
+// convertAttachments 첨부 파일 dto를 업로드 일시와 함께 변환
+func convertAttachments(attachmentDTOs []dto.AttachmentDTO) []models.Attachment {
+	attachments := make([]models.Attachment, 0, len(attachmentDTOs))
+	for _, attachment := range attachmentDTOs {
+		attachments = append(attachments, models.Attachment{Name: attachment.Name, URL: attachment.URL, UploadedAt: time.Now()})
+	}
+	return attachments
+}
+
+// findCandidate JobApplication이 참조할 지원자를 조회
+func (js *JobApplicationServiceImpl) findCandidate(ctx context.Context, id string) (*models.Candidate, error) {
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	var candidate models.Candidate
+	if err := js.candidateCollection.FindOne(ctx, bson.M{"_id": candidateId}).Decode(&candidate); err != nil {
+		return nil, findError("지원자를 찾을 수 없음", err)
+	}
+
+	return &candidate, nil
+}
+
+// stageChanges 부서 파이프라인으로 단계 이동을 검증하고 stage_history에 추가할 기록을 반환
+// 이동 기록이 없는 기존 지원서는 생성 시점의 현재 단계 기록을 함께 추가
+func (js *JobApplicationServiceImpl) stageChanges(ctx context.Context, current *models.JobApplication, departmentId primitive.ObjectID, stage string, userId primitive.ObjectID) ([]models.StageChange, error) {
+	pipeline, err := departmentPipeline(ctx, js.pipelineCollection, departmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipeline != nil {
+		if pipeline.StageIndex(stage) < 0 {
+			return nil, &errors.CustomError{
+				Message:    "채용 파이프라인에 없는 단계",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("unknown stage: %s", stage),
+			}
+		}
+		if !pipeline.CanMove(current.Stage, stage) {
+			return nil, &errors.CustomError{
+				Message:    "허용되지 않는 단계 이동",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("cannot move from %s to %s", current.Stage, stage),
+			}
+		}
+	}
+
+	var changes []models.StageChange
+	if len(current.StageHistory) == 0 && current.Stage != "" {
+		changes = append(changes, models.StageChange{Stage: current.Stage, MovedAt: current.CreatedAt})
+	}
+
+	changes = append(changes, models.StageChange{
+		Stage:   stage,
+		From:    current.Stage,
+		MovedBy: userId,
+		MovedAt: time.Now(),
+	})
+
+	return changes, nil
+}
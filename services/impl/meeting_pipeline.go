@@ -0,0 +1,205 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Meeting 조회 시 참석자 역할 필터
+const (
+	MeetingRoleCreator     = "creator"
+	MeetingRoleParticipant = "participant"
+)
+
+// Meeting 조회 시 시점 필터
+const (
+	MeetingWhenUpcoming = "upcoming"
+	MeetingWhenPast     = "past"
+)
+
+// meetingPipeline 모든 Meeting 조회가 공유하는 aggregation
+// 휴지통에 있는 Meeting과 match 조건으로 거른 뒤 작성자/참석자 정보를 조회하고, 참석자별로 풀었던 문서를 Meeting 단위로 다시 묶음
+func meetingPipeline(match bson.D) mongo.Pipeline {
+	pipeline := mongo.Pipeline{notDeletedStage}
+
+	if len(match) > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+	}
+
+	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "users"},
+		{Key: "localField", Value: "created_by"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "created_by_info"},
+	}}}
+
+	// Unwind participants 배열
+	unwindStage := bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$participants"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}}
+
+	lookupParticipantsStage := bson.D{
+		{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "users"},
+			{Key: "localField", Value: "participants.participant"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "participants.participant_info"},
+		}},
+	}
+
+	// 필드를 하나씩 나열하지 않고 원본 문서를 그대로 유지한 채 participants만 다시 모음
+	groupStage := bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$_id"},
+			{Key: "meeting", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+			{Key: "participants", Value: bson.D{{Key: "$push", Value: "$participants"}}},
+		}},
+	}
+
+	replaceRootStage := bson.D{{Key: "$replaceRoot", Value: bson.D{
+		{Key: "newRoot", Value: bson.D{{Key: "$mergeObjects", Value: bson.A{
+			"$meeting",
+			bson.D{{Key: "participants", Value: "$participants"}},
+		}}}},
+	}}}
+
+	sortStage := bson.D{{Key: "$sort", Value: bson.D{{Key: "start_dt", Value: 1}, {Key: "_id", Value: 1}}}}
+
+	return append(pipeline, lookupUserStage, unwindStage, lookupParticipantsStage, groupStage, replaceRootStage, sortStage)
+}
+
+// meetingUserMatch 역할(role)에 따라 사용자가 작성했거나 참석하는 Meeting 조건을 생성
+func meetingUserMatch(userId primitive.ObjectID, role string) (bson.D, error) {
+	switch role {
+	case MeetingRoleCreator:
+		return bson.D{{Key: "created_by", Value: userId}}, nil
+	case MeetingRoleParticipant:
+		return bson.D{{Key: "participants.participant", Value: userId}}, nil
+	case "":
+		return bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "created_by", Value: userId}},
+			bson.D{{Key: "participants.participant", Value: userId}},
+		}}}, nil
+	}
+
+	return nil, &errors.CustomError{
+		Message:    "유효하지 않은 역할 필터",
+		StatusCode: http.StatusBadRequest,
+		Err:        fmt.Errorf("invalid role: %s", role),
+	}
+}
+
+// meetingWhenMatch 현재 시각 기준 예정/지난 Meeting 조건을 생성
+// 반복 Meeting은 시작 일시가 지났더라도 반복이 끝나지 않았으면 예정 Meeting으로 봄
+func meetingWhenMatch(when string, now time.Time) (bson.D, error) {
+	switch when {
+	case MeetingWhenUpcoming:
+		return bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "start_dt", Value: bson.D{{Key: "$gte", Value: now}}}},
+			bson.D{
+				{Key: "recurrence", Value: bson.D{{Key: "$exists", Value: true}}},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "recurrence.until", Value: bson.D{{Key: "$exists", Value: false}}}},
+					bson.D{{Key: "recurrence.until", Value: bson.D{{Key: "$gte", Value: now}}}},
+				}},
+			},
+		}}}, nil
+	case MeetingWhenPast:
+		return bson.D{{Key: "start_dt", Value: bson.D{{Key: "$lt", Value: now}}}}, nil
+	case "":
+		return nil, nil
+	}
+
+	return nil, &errors.CustomError{
+		Message:    "유효하지 않은 시점 필터",
+		StatusCode: http.StatusBadRequest,
+		Err:        fmt.Errorf("invalid when: %s", when),
+	}
+}
+
+// meetingQueryMatch 조회 조건(사용자, 역할, 시점, 기간)을 하나의 match 조건으로 합침
+func meetingQueryMatch(userId primitive.ObjectID, query *dto.MeetingQueryDTO, now time.Time) (bson.D, error) {
+	conditions := bson.A{}
+
+	if !userId.IsZero() {
+		userMatch, err := meetingUserMatch(userId, query.Role)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, userMatch)
+	}
+
+	whenMatch, err := meetingWhenMatch(query.When, now)
+	if err != nil {
+		return nil, err
+	}
+	if whenMatch != nil {
+		conditions = append(conditions, whenMatch)
+	}
+
+	// 기간 조회 시 시작 일시가 기간 종료 이후인 Meeting은 회차가 있을 수 없음
+	// 단, 회차를 기간 안으로 앞당긴 예외가 있으면 포함
+	if !query.To.IsZero() {
+		conditions = append(conditions, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "start_dt", Value: bson.D{{Key: "$lt", Value: query.To}}}},
+			bson.D{{Key: "exceptions.start_dt", Value: bson.D{{Key: "$lt", Value: query.To}}}},
+		}}})
+	}
+
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	return bson.D{{Key: "$and", Value: conditions}}, nil
+}
+
+// filterMeetingsByWhen 회차별로 펼친 Meeting을 현재 시각 기준으로 거름
+func filterMeetingsByWhen(meetings []models.Meeting, when string, now time.Time) []models.Meeting {
+	if when == "" {
+		return meetings
+	}
+
+	filtered := []models.Meeting{}
+	for _, meeting := range meetings {
+		upcoming := !meeting.StartDt.Before(now)
+		if (when == MeetingWhenUpcoming) == upcoming {
+			filtered = append(filtered, meeting)
+		}
+	}
+
+	return filtered
+}
+
+// aggregateMeetings meetingPipeline을 실행하여 결과를 디코딩
+func (ms *MeetingServiceImpl) aggregateMeetings(ctx context.Context, match bson.D) ([]models.Meeting, error) {
+	var meetings []models.Meeting
+
+	results, err := ms.collection.Aggregate(ctx, meetingPipeline(match))
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &meetings); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return meetings, nil
+}
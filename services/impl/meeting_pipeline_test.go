@@ -0,0 +1,259 @@
+package impl
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// stageNames 파이프라인 각 단계의 연산자 이름
+func stageNames(stages []bson.D) []string {
+	names := make([]string, len(stages))
+	for i, stage := range stages {
+		names[i] = stage[0].Key
+	}
+	return names
+}
+
+func TestMeetingPipeline(t *testing.T) {
+	match := bson.D{{Key: "created_by", Value: primitive.NewObjectID()}}
+
+	tests := []struct {
+		name  string
+		match bson.D
+		want  []string
+	}{
+		{
+			name:  "without match",
+			match: nil,
+			want:  []string{"$match", "$lookup", "$unwind", "$lookup", "$group", "$replaceRoot", "$sort"},
+		},
+		{
+			name:  "with match",
+			match: match,
+			want:  []string{"$match", "$match", "$lookup", "$unwind", "$lookup", "$group", "$replaceRoot", "$sort"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := meetingPipeline(tt.match)
+
+			if got := stageNames(pipeline); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got stages %v, want %v", got, tt.want)
+			}
+
+			// 휴지통 필터는 항상 첫 단계
+			if !reflect.DeepEqual(pipeline[0], notDeletedStage) {
+				t.Errorf("first stage: got %v, want %v", pipeline[0], notDeletedStage)
+			}
+
+			if tt.match != nil {
+				want := bson.D{{Key: "$match", Value: tt.match}}
+				if !reflect.DeepEqual(pipeline[1], want) {
+					t.Errorf("match stage: got %v, want %v", pipeline[1], want)
+				}
+			}
+
+			// 시작 일시, _id 순으로 정렬해야 같은 시각의 Meeting 순서가 고정됨
+			wantSort := bson.D{{Key: "$sort", Value: bson.D{{Key: "start_dt", Value: 1}, {Key: "_id", Value: 1}}}}
+			if last := pipeline[len(pipeline)-1]; !reflect.DeepEqual(last, wantSort) {
+				t.Errorf("sort stage: got %v, want %v", last, wantSort)
+			}
+
+			// 반복 Meeting은 조회 후 회차별로 펼치므로 파이프라인에서 건너뛰거나 잘라내면 안 됨
+			for _, name := range stageNames(pipeline) {
+				if name == "$skip" || name == "$limit" {
+					t.Errorf("unexpected pagination stage %s", name)
+				}
+			}
+		})
+	}
+}
+
+func TestMeetingUserMatch(t *testing.T) {
+	userId := primitive.NewObjectID()
+
+	tests := []struct {
+		role string
+		want bson.D
+	}{
+		{
+			role: MeetingRoleCreator,
+			want: bson.D{{Key: "created_by", Value: userId}},
+		},
+		{
+			role: MeetingRoleParticipant,
+			want: bson.D{{Key: "participants.participant", Value: userId}},
+		},
+		{
+			role: "",
+			want: bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "created_by", Value: userId}},
+				bson.D{{Key: "participants.participant", Value: userId}},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			got, err := meetingUserMatch(userId, tt.role)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := meetingUserMatch(userId, "owner"); !isBadRequest(err) {
+		t.Errorf("invalid role: got %v, want bad request", err)
+	}
+}
+
+func TestMeetingWhenMatch(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	upcoming, err := meetingWhenMatch(MeetingWhenUpcoming, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantUpcoming := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "start_dt", Value: bson.D{{Key: "$gte", Value: now}}}},
+		bson.D{
+			{Key: "recurrence", Value: bson.D{{Key: "$exists", Value: true}}},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "recurrence.until", Value: bson.D{{Key: "$exists", Value: false}}}},
+				bson.D{{Key: "recurrence.until", Value: bson.D{{Key: "$gte", Value: now}}}},
+			}},
+		},
+	}}}
+	if !reflect.DeepEqual(upcoming, wantUpcoming) {
+		t.Errorf("upcoming: got %v, want %v", upcoming, wantUpcoming)
+	}
+
+	past, err := meetingWhenMatch(MeetingWhenPast, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantPast := bson.D{{Key: "start_dt", Value: bson.D{{Key: "$lt", Value: now}}}}
+	if !reflect.DeepEqual(past, wantPast) {
+		t.Errorf("past: got %v, want %v", past, wantPast)
+	}
+
+	if none, err := meetingWhenMatch("", now); err != nil || none != nil {
+		t.Errorf("empty: got %v, %v, want nil", none, err)
+	}
+
+	if _, err := meetingWhenMatch("soon", now); !isBadRequest(err) {
+		t.Errorf("invalid when: got %v, want bad request", err)
+	}
+}
+
+func TestMeetingQueryMatch(t *testing.T) {
+	userId := primitive.NewObjectID()
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	to := now.AddDate(0, 1, 0)
+
+	toMatch := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "start_dt", Value: bson.D{{Key: "$lt", Value: to}}}},
+		bson.D{{Key: "exceptions.start_dt", Value: bson.D{{Key: "$lt", Value: to}}}},
+	}}}
+
+	tests := []struct {
+		name   string
+		userId primitive.ObjectID
+		query  dto.MeetingQueryDTO
+		want   bson.D
+	}{
+		{
+			name:  "no conditions",
+			query: dto.MeetingQueryDTO{},
+			want:  nil,
+		},
+		{
+			name:   "user and role",
+			userId: userId,
+			query:  dto.MeetingQueryDTO{Role: MeetingRoleCreator},
+			want: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "created_by", Value: userId}},
+			}}},
+		},
+		{
+			name:   "user, when and window",
+			userId: userId,
+			query:  dto.MeetingQueryDTO{Role: MeetingRoleParticipant, When: MeetingWhenPast, From: now, To: to},
+			want: bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "participants.participant", Value: userId}},
+				bson.D{{Key: "start_dt", Value: bson.D{{Key: "$lt", Value: now}}}},
+				toMatch,
+			}}},
+		},
+		{
+			name:  "window only",
+			query: dto.MeetingQueryDTO{From: now, To: to},
+			want:  bson.D{{Key: "$and", Value: bson.A{toMatch}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := meetingQueryMatch(tt.userId, &tt.query, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := meetingQueryMatch(userId, &dto.MeetingQueryDTO{Role: "owner"}, now); !isBadRequest(err) {
+		t.Errorf("invalid role: got %v, want bad request", err)
+	}
+}
+
+func TestFilterMeetingsByWhen(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	meetings := []models.Meeting{
+		{StartDt: now.Add(-time.Hour)},
+		{StartDt: now},
+		{StartDt: now.Add(time.Hour)},
+	}
+
+	tests := []struct {
+		when string
+		want []time.Time
+	}{
+		{when: "", want: []time.Time{now.Add(-time.Hour), now, now.Add(time.Hour)}},
+		{when: MeetingWhenUpcoming, want: []time.Time{now, now.Add(time.Hour)}},
+		{when: MeetingWhenPast, want: []time.Time{now.Add(-time.Hour)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.when, func(t *testing.T) {
+			got := filterMeetingsByWhen(meetings, tt.when, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d meetings, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !got[i].StartDt.Equal(tt.want[i]) {
+					t.Errorf("meeting %d: got %v, want %v", i, got[i].StartDt, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func isBadRequest(err error) bool {
+	customErr, ok := err.(*errors.CustomError)
+	return ok && customErr.StatusCode == http.StatusBadRequest
+}
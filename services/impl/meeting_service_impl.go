@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
@@ -18,77 +19,20 @@ import (
 )
 
 type MeetingServiceImpl struct {
-	collection *mongo.Collection
+	collection      *mongo.Collection
+	todoCollection  *mongo.Collection
+	auditCollection *mongo.Collection
 }
 
-func NewMeetingServiceImpl(collection *mongo.Collection) services.MeetingService {
-	return &MeetingServiceImpl{collection}
+func NewMeetingServiceImpl(collection *mongo.Collection, todoCollection *mongo.Collection, auditCollection *mongo.Collection) services.MeetingService {
+	return &MeetingServiceImpl{collection, todoCollection, auditCollection}
 }
 
 func (ms *MeetingServiceImpl) GetAllMeeting() ([]models.Meeting, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	var meetings []models.Meeting
-
-	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
-		{Key: "from", Value: "users"},
-		{Key: "localField", Value: "created_by"},
-		{Key: "foreignField", Value: "_id"},
-		{Key: "as", Value: "created_by_info"},
-	}}}
-
-	// Unwind participants 배열
-	unwindStage := bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$participants"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}}
-
-	lookupParticipantsStage := bson.D{
-		{Key: "$lookup", Value: bson.D{
-			{Key: "from", Value: "users"},
-			{Key: "localField", Value: "participants.participant"},
-			{Key: "foreignField", Value: "_id"},
-			{Key: "as", Value: "participants.participant_info"},
-		}},
-	}
-
-	groupStage := bson.D{
-		{Key: "$group", Value: bson.D{
-			{Key: "_id", Value: "$_id"},
-			{Key: "title", Value: bson.D{{Key: "$first", Value: "$title"}}},
-			{Key: "description", Value: bson.D{{Key: "$first", Value: "$description"}}},
-			{Key: "participants", Value: bson.D{{Key: "$push", Value: "$participants"}}},
-			{Key: "start_dt", Value: bson.D{{Key: "$first", Value: "$start_dt"}}},
-			{Key: "end_dt", Value: bson.D{{Key: "$first", Value: "$end_dt"}}},
-			{Key: "location", Value: bson.D{{Key: "$first", Value: "$location"}}},
-			{Key: "created_by", Value: bson.D{{Key: "$first", Value: "$created_by"}}},
-			{Key: "created_by_info", Value: bson.D{{Key: "$first", Value: "$created_by_info"}}},
-			{Key: "created_at", Value: bson.D{{Key: "$first", Value: "$created_at"}}},
-			{Key: "updated_at", Value: bson.D{{Key: "$first", Value: "$updated_at"}}},
-		}},
-	}
-
-	pipeline := mongo.Pipeline{lookupUserStage, unwindStage, lookupParticipantsStage, groupStage}
-
-	results, err := ms.collection.Aggregate(ctx, pipeline)
-
-	if err != nil {
-		return nil, &errors.CustomError{
-			Message:    "내부 서버 오류",
-			StatusCode: http.StatusInternalServerError,
-			Err:        err,
-		}
-	}
-
-	defer results.Close(ctx)
-
-	if err = results.All(ctx, &meetings); err != nil {
-		return nil, &errors.CustomError{
-			Message:    "내부 서버 오류",
-			StatusCode: http.StatusInternalServerError,
-			Err:        err,
-		}
-	}
-
-	return meetings, nil
+	return ms.aggregateMeetings(ctx, nil)
 }
 
 func (ms *MeetingServiceImpl) GetMeeting(id string) (*models.Meeting, error) {
@@ -100,73 +44,22 @@ func (ms *MeetingServiceImpl) GetMeeting(id string) (*models.Meeting, error) {
 		return nil, utils.ConvertError("Meeting", err)
 	}
 
-	var meeting *models.Meeting
-
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: meetingId}}}}
-
-	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
-		{Key: "from", Value: "users"},
-		{Key: "localField", Value: "created_by"},
-		{Key: "foreignField", Value: "_id"},
-		{Key: "as", Value: "created_by_info"},
-	}}}
-
-	// Unwind participants 배열
-	unwindStage := bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$participants"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}}
-
-	lookupParticipantsStage := bson.D{
-		{Key: "$lookup", Value: bson.D{
-			{Key: "from", Value: "users"},
-			{Key: "localField", Value: "participants.participant"},
-			{Key: "foreignField", Value: "_id"},
-			{Key: "as", Value: "participants.participant_info"},
-		}},
-	}
-
-	groupStage := bson.D{
-		{Key: "$group", Value: bson.D{
-			{Key: "_id", Value: "$_id"},
-			{Key: "title", Value: bson.D{{Key: "$first", Value: "$title"}}},
-			{Key: "description", Value: bson.D{{Key: "$first", Value: "$description"}}},
-			{Key: "participants", Value: bson.D{{Key: "$push", Value: "$participants"}}},
-			{Key: "start_dt", Value: bson.D{{Key: "$first", Value: "$start_dt"}}},
-			{Key: "end_dt", Value: bson.D{{Key: "$first", Value: "$end_dt"}}},
-			{Key: "location", Value: bson.D{{Key: "$first", Value: "$location"}}},
-			{Key: "created_by", Value: bson.D{{Key: "$first", Value: "$created_by"}}},
-			{Key: "created_by_info", Value: bson.D{{Key: "$first", Value: "$created_by_info"}}},
-			{Key: "created_at", Value: bson.D{{Key: "$first", Value: "$created_at"}}},
-			{Key: "updated_at", Value: bson.D{{Key: "$first", Value: "$updated_at"}}},
-		}},
-	}
-
-	pipeline := mongo.Pipeline{matchStage, lookupUserStage, unwindStage, lookupParticipantsStage, groupStage}
-
-	result, err := ms.collection.Aggregate(ctx, pipeline)
-
+	meetings, err := ms.aggregateMeetings(ctx, bson.D{{Key: "_id", Value: meetingId}})
 	if err != nil {
-		return nil, &errors.CustomError{
-			Message:    "내부 서버 오류",
-			StatusCode: http.StatusInternalServerError,
-			Err:        err,
-		}
+		return nil, err
 	}
 
-	defer result.Close(ctx)
-
-	if result.Next(ctx) {
-		if err := result.Decode(&meeting); err != nil {
-			return nil, &errors.CustomError{
-				Message:    "결과 디코딩 오류",
-				StatusCode: http.StatusInternalServerError,
-				Err:        err,
-			}
-		}
+	if len(meetings) == 0 {
+		return nil, nil
 	}
 
+	meeting := &meetings[0]
+	meeting.SetRSVPSummary()
+
 	return meeting, nil
 }
 
-func (ms *MeetingServiceImpl) GetMeetingByUser(id string) ([]models.Meeting, error) {
+func (ms *MeetingServiceImpl) GetMeetingByUser(id string, query *dto.MeetingQueryDTO) ([]models.Meeting, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -175,72 +68,68 @@ func (ms *MeetingServiceImpl) GetMeetingByUser(id string) ([]models.Meeting, err
 		return nil, utils.ConvertError("User", err)
 	}
 
-	var meetings []models.Meeting
-
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "created_by", Value: userId}}}}
+	now := time.Now()
 
-	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
-		{Key: "from", Value: "users"},
-		{Key: "localField", Value: "created_by"},
-		{Key: "foreignField", Value: "_id"},
-		{Key: "as", Value: "created_by_info"},
-	}}}
-
-	// Unwind participants 배열
-	unwindStage := bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$participants"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}}
+	match, err := meetingQueryMatch(userId, query, now)
+	if err != nil {
+		return nil, err
+	}
 
-	lookupParticipantsStage := bson.D{
-		{Key: "$lookup", Value: bson.D{
-			{Key: "from", Value: "users"},
-			{Key: "localField", Value: "participants.participant"},
-			{Key: "foreignField", Value: "_id"},
-			{Key: "as", Value: "participants.participant_info"},
-		}},
+	meetings, err := ms.aggregateMeetings(ctx, match)
+	if err != nil {
+		return nil, err
 	}
 
-	groupStage := bson.D{
-		{Key: "$group", Value: bson.D{
-			{Key: "_id", Value: "$_id"},
-			{Key: "title", Value: bson.D{{Key: "$first", Value: "$title"}}},
-			{Key: "description", Value: bson.D{{Key: "$first", Value: "$description"}}},
-			{Key: "participants", Value: bson.D{{Key: "$push", Value: "$participants"}}},
-			{Key: "start_dt", Value: bson.D{{Key: "$first", Value: "$start_dt"}}},
-			{Key: "end_dt", Value: bson.D{{Key: "$first", Value: "$end_dt"}}},
-			{Key: "location", Value: bson.D{{Key: "$first", Value: "$location"}}},
-			{Key: "created_by", Value: bson.D{{Key: "$first", Value: "$created_by"}}},
-			{Key: "created_by_info", Value: bson.D{{Key: "$first", Value: "$created_by_info"}}},
-			{Key: "created_at", Value: bson.D{{Key: "$first", Value: "$created_at"}}},
-			{Key: "updated_at", Value: bson.D{{Key: "$first", Value: "$updated_at"}}},
-		}},
+	// 기간이 주어지면 반복 Meeting을 회차별로 펼쳐서 반환
+	if !query.From.IsZero() && !query.To.IsZero() {
+		return filterMeetingsByWhen(expandMeetings(meetings, query.From, query.To), query.When, now), nil
 	}
 
-	pipeline := mongo.Pipeline{matchStage, lookupUserStage, unwindStage, lookupParticipantsStage, groupStage}
+	return meetings, nil
+}
 
-	results, err := ms.collection.Aggregate(ctx, pipeline)
+func (ms *MeetingServiceImpl) GetMeetingCalendar(query *dto.MeetingQueryDTO) ([]models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-	if err != nil {
+	if query.From.IsZero() || query.To.IsZero() || !query.From.Before(query.To) {
 		return nil, &errors.CustomError{
-			Message:    "내부 서버 오류",
-			StatusCode: http.StatusInternalServerError,
-			Err:        err,
+			Message:    "조회 기간(from, to)이 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid calendar window: %s ~ %s", query.From, query.To),
 		}
 	}
 
-	defer results.Close(ctx)
-
-	if err = results.All(ctx, &meetings); err != nil {
+	if query.To.Sub(query.From) > maxCalendarWindow {
 		return nil, &errors.CustomError{
-			Message:    "내부 서버 오류",
-			StatusCode: http.StatusInternalServerError,
-			Err:        err,
+			Message:    "조회 기간은 1년을 넘을 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("calendar window too large: %s", query.To.Sub(query.From)),
 		}
 	}
 
-	return meetings, nil
+	userId, err := utils.ConvertToObjectId(query.User)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	now := time.Now()
+
+	match, err := meetingQueryMatch(userId, query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := ms.aggregateMeetings(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterMeetingsByWhen(expandMeetings(meetings, query.From, query.To), query.When, now), nil
 }
 
-func (ms *MeetingServiceImpl) CreateMeeting(dto *dto.MeetingCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ms *MeetingServiceImpl) CreateMeeting(ctx context.Context, dto *dto.MeetingCreateDTO) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
@@ -262,6 +151,12 @@ func (ms *MeetingServiceImpl) CreateMeeting(dto *dto.MeetingCreateDTO) error {
 		return utils.ConvertError("User", err)
 	}
 
+	if dto.Recurrence != nil {
+		if meeting.Recurrence, err = convertRecurrence(dto.Recurrence); err != nil {
+			return err
+		}
+	}
+
 	// Participants 필드를 ObjectId로 변환하여 한 번에 할당
 	var participantIDs []primitive.ObjectID
 	if participantIDs, err = utils.ConvertStringIDsToObjectIDs(dto.Participants); err != nil {
@@ -270,7 +165,7 @@ func (ms *MeetingServiceImpl) CreateMeeting(dto *dto.MeetingCreateDTO) error {
 
 	meeting.Participants = make([]models.Participant, len(participantIDs))
 	for i, objID := range participantIDs {
-		meeting.Participants[i] = models.Participant{User: objID}
+		meeting.Participants[i] = models.Participant{User: objID, Status: models.RSVPPending}
 	}
 
 	fmt.Printf("meeting: %+v", meeting)
@@ -285,11 +180,15 @@ func (ms *MeetingServiceImpl) CreateMeeting(dto *dto.MeetingCreateDTO) error {
 		}
 	}
 
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meeting.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (ms *MeetingServiceImpl) UpdateMeeting(id string, dto *dto.MeetingUpdateDTO) (*models.Meeting, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ms *MeetingServiceImpl) UpdateMeeting(ctx context.Context, id string, dto *dto.MeetingUpdateDTO) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	meetingId, err := utils.ConvertToObjectId(id)
@@ -321,33 +220,77 @@ func (ms *MeetingServiceImpl) UpdateMeeting(id string, dto *dto.MeetingUpdateDTO
 		meeting["end_dt"] = dto.EndDt
 	}
 
+	var recurrence *models.Recurrence
+	if dto.Recurrence != nil {
+		if recurrence, err = convertRecurrence(dto.Recurrence); err != nil {
+			return nil, err
+		}
+		meeting["recurrence"] = recurrence
+	}
+
+	var current models.Meeting
+	if len(dto.Participants) > 0 || !dto.StartDt.IsZero() || recurrence != nil {
+		if err := ms.collection.FindOne(ctx, bson.M{"_id": meetingId, "deleted_at": nil}).Decode(&current); err != nil && err != mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+	}
+
+	// 예외는 원래 회차 일시로 찾으므로 시리즈 일정이 바뀌면 새 회차에 맞게 옮기거나 버림
+	if len(current.Exceptions) > 0 && (!dto.StartDt.IsZero() || recurrence != nil) {
+		if recurrence == nil {
+			recurrence = current.Recurrence
+		}
+		start := current.StartDt
+		if !dto.StartDt.IsZero() {
+			start = dto.StartDt
+		}
+		meeting["exceptions"] = remapExceptions(current.Exceptions, recurrence, current.StartDt, start)
+	}
+
 	if len(dto.Participants) > 0 {
 		participantIDs, err := utils.ConvertStringIDsToObjectIDs(dto.Participants)
 		if err != nil {
 			return nil, utils.ConvertError("Participant", err)
 		}
 
+		// 기존 참석자의 응답/출석 정보는 유지
+
+		existing := make(map[primitive.ObjectID]models.Participant, len(current.Participants))
+		for _, participant := range current.Participants {
+			existing[participant.User] = participant
+		}
+
 		participants := make([]models.Participant, len(participantIDs))
 		for i, objID := range participantIDs {
-			participants[i] = models.Participant{User: objID}
+			if participant, ok := existing[objID]; ok {
+				participants[i] = participant
+			} else {
+				participants[i] = models.Participant{User: objID, Status: models.RSVPPending}
+			}
 		}
 
 		meeting["participants"] = participants
 	}
 
-	filter := bson.M{"_id": meetingId}
-	update := bson.M{"$set": meeting}
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	filter := versionFilter(ctx, bson.M{"_id": meetingId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": meeting})
 
 	fmt.Printf("meeting: %+v", meeting)
 
 	result := ms.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, ms.collection, meetingId, &errors.CustomError{
 				Message:    "Meeting을 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -365,11 +308,15 @@ func (ms *MeetingServiceImpl) UpdateMeeting(id string, dto *dto.MeetingUpdateDTO
 		}
 	}
 
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedMeeting, nil
 }
 
-func (ms *MeetingServiceImpl) DeleteMeeting(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ms *MeetingServiceImpl) DeleteMeeting(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	meetingId, err := utils.ConvertToObjectId(id)
@@ -377,9 +324,11 @@ func (ms *MeetingServiceImpl) DeleteMeeting(id string) error {
 		return utils.ConvertError("Meeting", err)
 	}
 
-	filter := bson.M{"_id": meetingId}
+	before := auditSnapshot(ctx, ms.collection, meetingId)
 
-	result, err := ms.collection.DeleteOne(ctx, filter)
+	filter := versionFilter(ctx, bson.M{"_id": meetingId})
+
+	result, err := softDelete(ctx, ms.collection, filter, nil)
 	if err != nil {
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -388,13 +337,660 @@ func (ms *MeetingServiceImpl) DeleteMeeting(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
-		return &errors.CustomError{
+	if result.MatchedCount == 0 {
+		return matchError(ctx, ms.collection, meetingId, &errors.CustomError{
 			Message:    "Meeting을 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
-		}
+		})
+	}
+
+	if err := recordAudit(ctx, ms.auditCollection, models.AuditResourceMeeting, models.AuditActionDelete, meetingId, before, auditSnapshot(ctx, ms.collection, meetingId)); err != nil {
+		return err
 	}
 
 	return nil
 }
+
+func (ms *MeetingServiceImpl) RespondMeeting(ctx context.Context, id string, dto *dto.MeetingRSVPDTO, userId string) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meetingId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	participantId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if !models.IsValidRSVPStatus(dto.Status) {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 참석 응답 상태",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid rsvp status: %s", dto.Status),
+		}
+	}
+
+	// 참석자 본인만 응답할 수 있으므로 로그인한 유저의 항목만 갱신
+	filter := versionFilter(ctx, bson.M{"_id": meetingId, "participants.participant": participantId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"participants.$.status":       dto.Status,
+		"participants.$.comment":      dto.Comment,
+		"participants.$.responded_at": time.Now(),
+		"updated_at":                  time.Now(),
+	}})
+
+	result, err := ms.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, matchError(ctx, ms.collection, meetingId, &errors.CustomError{
+			Message:    "Meeting 참석자를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+
+	return ms.GetMeeting(id)
+}
+
+func (ms *MeetingServiceImpl) MarkAttendance(ctx context.Context, id string, dto *dto.MeetingAttendanceDTO, userId string) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meetingId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	var meeting models.Meeting
+	if err := ms.collection.FindOne(ctx, bson.M{"_id": meetingId, "deleted_at": nil}).Decode(&meeting); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Meeting을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	currentUserId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if meeting.User != currentUserId {
+		return nil, &errors.CustomError{
+			Message:    "Meeting 작성자만 출석을 기록할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s is not the creator of meeting %s", currentUserId.Hex(), meetingId.Hex()),
+		}
+	}
+
+	// 출석은 Meeting 시작 이후에만 기록
+	if meeting.StartDt.After(time.Now()) {
+		return nil, &errors.CustomError{
+			Message:    "Meeting 시작 전에는 출석을 기록할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("meeting starts at %s", meeting.StartDt),
+		}
+	}
+
+	participants := make(map[primitive.ObjectID]bool, len(meeting.Participants))
+	for _, participant := range meeting.Participants {
+		participants[participant.User] = true
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	var arrayFilters []interface{}
+
+	for i, attendance := range dto.Attendances {
+		userId, err := utils.ConvertToObjectId(attendance.Participant)
+		if err != nil {
+			return nil, utils.ConvertError("Participant", err)
+		}
+
+		if !participants[userId] {
+			return nil, &errors.CustomError{
+				Message:    "Meeting 참석자를 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        fmt.Errorf("participant %s not in meeting", attendance.Participant),
+			}
+		}
+
+		identifier := fmt.Sprintf("p%d", i)
+		set[fmt.Sprintf("participants.$[%s].attended", identifier)] = attendance.Attended
+		arrayFilters = append(arrayFilters, bson.M{identifier + ".participant": userId})
+	}
+
+	if len(arrayFilters) > 0 {
+		opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: arrayFilters})
+		result, err := ms.collection.UpdateOne(ctx, versionFilter(ctx, bson.M{"_id": meetingId, "deleted_at": nil}), bumpVersion(bson.M{"$set": set}), opts)
+		if err != nil {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if result.MatchedCount == 0 {
+			return nil, matchError(ctx, ms.collection, meetingId, &errors.CustomError{
+				Message:    "Meeting을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        mongo.ErrNoDocuments,
+			})
+		}
+	}
+
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+
+	return ms.GetMeeting(id)
+}
+
+func (ms *MeetingServiceImpl) UpdateMeetingOccurrence(ctx context.Context, id string, dto *dto.MeetingOccurrenceDTO) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meetingId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	var meeting models.Meeting
+	if err := ms.collection.FindOne(ctx, bson.M{"_id": meetingId, "deleted_at": nil}).Decode(&meeting); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Meeting을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if meeting.Recurrence == nil {
+		return nil, &errors.CustomError{
+			Message:    "반복 Meeting이 아님",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("meeting %s has no recurrence", id),
+		}
+	}
+
+	// 요청한 회차가 실제 반복 규칙에 포함되는지 확인
+	original := dto.OriginalStartDt
+	if len(utils.ExpandRecurrence(meeting.Recurrence, meeting.StartDt, original, original.Add(time.Millisecond))) == 0 {
+		return nil, &errors.CustomError{
+			Message:    "해당 일시의 Meeting 회차를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        fmt.Errorf("no occurrence at %s", original),
+		}
+	}
+
+	exception := models.MeetingException{
+		OriginalStartDt: original,
+		Cancelled:       dto.Cancelled,
+		Title:           dto.Title,
+		Description:     dto.Description,
+		Location:        dto.Location,
+		StartDt:         dto.StartDt,
+		EndDt:           dto.EndDt,
+	}
+
+	// 같은 회차의 기존 예외는 새 예외로 교체
+	exceptions := []models.MeetingException{exception}
+	for _, e := range meeting.Exceptions {
+		if !e.OriginalStartDt.Equal(original) {
+			exceptions = append(exceptions, e)
+		}
+	}
+
+	update := bson.M{"$set": bson.M{"exceptions": exceptions, "updated_at": time.Now()}}
+
+	result, err := ms.collection.UpdateOne(ctx, versionFilter(ctx, bson.M{"_id": meetingId, "deleted_at": nil}), bumpVersion(update))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, matchError(ctx, ms.collection, meetingId, &errors.CustomError{
+			Message:    "Meeting을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+
+	return ms.GetMeeting(id)
+}
+
+func (ms *MeetingServiceImpl) UpdateMeetingMinutes(ctx context.Context, id string, dto *dto.MeetingMinutesDTO) (*models.Meeting, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meetingId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	var meeting models.Meeting
+	if err := ms.collection.FindOne(ctx, bson.M{"_id": meetingId, "deleted_at": nil}).Decode(&meeting); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Meeting을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	// 이미 Todo로 변환된 액션 아이템은 변환 정보를 유지
+	converted := make(map[primitive.ObjectID]*primitive.ObjectID)
+	if meeting.Minutes != nil {
+		for _, item := range meeting.Minutes.ActionItems {
+			converted[item.ID] = item.Todo
+		}
+	}
+
+	minutes := models.MeetingMinutes{
+		Decisions: dto.Decisions,
+		UpdatedAt: time.Now(),
+	}
+
+	for _, agenda := range dto.AgendaItems {
+		minutes.AgendaItems = append(minutes.AgendaItems, models.AgendaItem{Title: agenda.Title, Notes: agenda.Notes})
+	}
+
+	for _, actionItem := range dto.ActionItems {
+		item := models.ActionItem{
+			ID:          primitive.NewObjectID(),
+			Description: actionItem.Description,
+			DueDt:       actionItem.DueDt,
+		}
+
+		if actionItem.ID != "" {
+			if item.ID, err = utils.ConvertToObjectId(actionItem.ID); err != nil {
+				return nil, utils.ConvertError("ActionItem", err)
+			}
+			item.Todo = converted[item.ID]
+		}
+
+		if item.Assignee, err = utils.ConvertToObjectId(actionItem.Assignee); err != nil {
+			return nil, utils.ConvertError("User", err)
+		}
+
+		minutes.ActionItems = append(minutes.ActionItems, item)
+	}
+
+	update := bson.M{"$set": bson.M{"minutes": minutes, "updated_at": time.Now()}}
+
+	result, err := ms.collection.UpdateOne(ctx, versionFilter(ctx, bson.M{"_id": meetingId, "deleted_at": nil}), bumpVersion(update))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return nil, matchError(ctx, ms.collection, meetingId, &errors.CustomError{
+			Message:    "Meeting을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+
+	return ms.GetMeeting(id)
+}
+
+func (ms *MeetingServiceImpl) ConvertActionItemsToTodos(ctx context.Context, id string) ([]models.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meetingId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Meeting", err)
+	}
+
+	var meeting models.Meeting
+	if err := ms.collection.FindOne(ctx, bson.M{"_id": meetingId, "deleted_at": nil}).Decode(&meeting); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Meeting을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	todos := []models.Todo{}
+	if meeting.Minutes == nil {
+		return todos, nil
+	}
+
+	before := auditSnapshot(ctx, ms.collection, meetingId)
+
+	// 담당자가 지정되어 있고 아직 변환되지 않은 액션 아이템만 Todo로 변환
+	candidates := []models.Todo{}
+	itemIds := []primitive.ObjectID{}
+	for _, item := range meeting.Minutes.ActionItems {
+		if item.Todo != nil || item.Assignee.IsZero() {
+			continue
+		}
+
+		todo := models.Todo{
+			ID:        primitive.NewObjectID(),
+			Task:      item.Description,
+			Status:    models.TodoStatusTodo,
+			StartDt:   time.Now(),
+			User:      item.Assignee,
+			Meeting:   meeting.ID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+
+		if item.DueDt != nil {
+			todo.EndDt = *item.DueDt
+		}
+
+		candidates = append(candidates, todo)
+		itemIds = append(itemIds, item.ID)
+	}
+
+	if len(candidates) == 0 {
+		return todos, nil
+	}
+
+	session, err := ms.collection.Database().Client().StartSession()
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer session.EndSession(ctx)
+
+	// 액션 아이템 선점과 Todo 생성을 한 트랜잭션으로 처리하여 선점만 남고 Todo가 없는 상태를 막음
+	// 동시에 요청하면 쓰기 충돌로 재시도되고, 재시도에서는 이미 선점된 아이템을 건너뛰므로 한 번만 변환됨
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		todos = []models.Todo{}
+
+		for i, todo := range candidates {
+			claimed, err := ms.claimActionItem(sessCtx, meetingId, itemIds[i], todo.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			if claimed {
+				todos = append(todos, todo)
+			}
+		}
+
+		if len(todos) == 0 {
+			return nil, nil
+		}
+
+		documents := make([]interface{}, len(todos))
+		for i, todo := range todos {
+			documents[i] = todo
+		}
+
+		return ms.todoCollection.InsertMany(sessCtx, documents)
+	})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if len(todos) == 0 {
+		return todos, nil
+	}
+
+	if err := recordChange(ctx, ms.auditCollection, ms.collection, models.AuditResourceMeeting, meetingId, before); err != nil {
+		return nil, err
+	}
+	for _, todo := range todos {
+		if err := recordChange(ctx, ms.auditCollection, ms.todoCollection, models.AuditResourceTodo, todo.ID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return todos, nil
+}
+
+// claimActionItem 아직 변환되지 않은 액션 아이템에 Todo ID를 기록, 다른 요청이 먼저 변환했으면 false
+// 트랜잭션 재시도 여부를 판단할 수 있도록 드라이버 오류를 그대로 반환
+func (ms *MeetingServiceImpl) claimActionItem(ctx context.Context, meetingId primitive.ObjectID, itemId primitive.ObjectID, todoId primitive.ObjectID) (bool, error) {
+	filter := bson.M{
+		"_id":                  meetingId,
+		"deleted_at":           nil,
+		"minutes.action_items": bson.M{"$elemMatch": bson.M{"_id": itemId, "todo": nil}},
+	}
+	update := bumpVersion(bson.M{"$set": bson.M{"minutes.action_items.$[a].todo": todoId, "updated_at": time.Now()}})
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{bson.M{"a._id": itemId}}})
+
+	result, err := ms.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// 캘린더 조회 시 허용하는 최대 기간
+const maxCalendarWindow = 366 * 24 * time.Hour
+
+// convertRecurrence 반복 규칙 dto를 검증하여 모델로 변환
+func convertRecurrence(dto *dto.RecurrenceDTO) (*models.Recurrence, error) {
+	invalid := func(reason string) error {
+		return &errors.CustomError{
+			Message:    "유효하지 않은 반복 규칙",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid recurrence: %s", reason),
+		}
+	}
+
+	switch dto.Freq {
+	case models.FreqDaily, models.FreqWeekly, models.FreqMonthly:
+	default:
+		return nil, invalid("freq must be daily, weekly or monthly")
+	}
+
+	if dto.Interval < 0 || dto.Count < 0 {
+		return nil, invalid("interval and count must not be negative")
+	}
+
+	for _, day := range dto.ByDay {
+		if !utils.IsValidWeekday(day) {
+			return nil, invalid("by_day must be one of SU, MO, TU, WE, TH, FR, SA")
+		}
+	}
+
+	if len(dto.ByDay) > 0 && dto.Freq != models.FreqWeekly {
+		return nil, invalid("by_day is only allowed for weekly freq")
+	}
+
+	if dto.TimeZone != "" {
+		if _, err := time.LoadLocation(dto.TimeZone); err != nil {
+			return nil, invalid("unknown time_zone")
+		}
+	}
+
+	interval := dto.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	return &models.Recurrence{
+		Freq:     dto.Freq,
+		Interval: interval,
+		ByDay:    dto.ByDay,
+		Until:    dto.Until,
+		Count:    dto.Count,
+		TimeZone: dto.TimeZone,
+	}, nil
+}
+
+// remapExceptions 시리즈 시작 일시가 옮겨진 만큼 예외의 원래 회차 일시를 옮기고, 새 반복 규칙에 없는 회차의 예외는 버림
+func remapExceptions(exceptions []models.MeetingException, rule *models.Recurrence, oldStart, newStart time.Time) []models.MeetingException {
+	remapped := []models.MeetingException{}
+	if rule == nil {
+		return remapped
+	}
+
+	shift := newStart.Sub(oldStart)
+	for _, e := range exceptions {
+		original := e.OriginalStartDt.Add(shift)
+		if len(utils.ExpandRecurrence(rule, newStart, original, original.Add(time.Millisecond))) == 0 {
+			continue
+		}
+		e.OriginalStartDt = original
+		remapped = append(remapped, e)
+	}
+
+	return remapped
+}
+
+// expandMeetings 기간 [from, to)에 걸치는 Meeting을 반환하며, 반복 Meeting은 회차별로 펼치고 예외(취소/변경)를 적용
+func expandMeetings(meetings []models.Meeting, from, to time.Time) []models.Meeting {
+	expanded := []models.Meeting{}
+
+	for _, meeting := range meetings {
+		if meeting.Recurrence == nil {
+			end := meeting.EndDt
+			if end.IsZero() {
+				end = meeting.StartDt
+			}
+			if meeting.StartDt.Before(to) && !end.Before(from) {
+				expanded = append(expanded, meeting)
+			}
+			continue
+		}
+
+		var duration time.Duration
+		if !meeting.EndDt.IsZero() {
+			duration = meeting.EndDt.Sub(meeting.StartDt)
+		}
+
+		// 변경된 회차는 기간 밖에서 안으로(또는 반대로) 옮겨질 수 있으므로
+		// 가장 크게 옮겨진 만큼 기간을 넓혀 펼친 뒤 최종 시작 일시로 거름
+		var maxShift time.Duration
+		exceptions := make(map[int64]models.MeetingException, len(meeting.Exceptions))
+		for _, e := range meeting.Exceptions {
+			exceptions[e.OriginalStartDt.UnixMilli()] = e
+			if e.StartDt != nil {
+				shift := e.StartDt.Sub(e.OriginalStartDt)
+				if shift < 0 {
+					shift = -shift
+				}
+				if shift > maxShift {
+					maxShift = shift
+				}
+			}
+		}
+
+		for _, start := range utils.ExpandRecurrence(meeting.Recurrence, meeting.StartDt, from.Add(-maxShift), to.Add(maxShift)) {
+			original := start
+
+			occurrence := meeting
+			occurrence.Exceptions = nil
+			occurrence.OriginalStartDt = &original
+			occurrence.StartDt = start
+			if duration > 0 {
+				occurrence.EndDt = start.Add(duration)
+			}
+
+			if e, ok := exceptions[start.UnixMilli()]; ok {
+				if e.Cancelled {
+					continue
+				}
+				if e.Title != "" {
+					occurrence.Title = e.Title
+				}
+				if e.Description != "" {
+					occurrence.Description = e.Description
+				}
+				if e.Location != "" {
+					occurrence.Location = e.Location
+				}
+				if e.StartDt != nil {
+					occurrence.StartDt = *e.StartDt
+				}
+				if e.EndDt != nil {
+					occurrence.EndDt = *e.EndDt
+				}
+			}
+
+			if occurrence.StartDt.Before(from) || !occurrence.StartDt.Before(to) {
+				continue
+			}
+
+			expanded = append(expanded, occurrence)
+		}
+	}
+
+	sort.Slice(expanded, func(i, j int) bool {
+		return expanded[i].StartDt.Before(expanded[j].StartDt)
+	})
+
+	return expanded
+}
@@ -0,0 +1,203 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+func TestExpandMeetingsExceptions(t *testing.T) {
+	day := func(d, h int) time.Time {
+		return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	// 2024-01-01(월)부터 매일 9시~10시
+	series := func(exceptions ...models.MeetingException) models.Meeting {
+		return models.Meeting{
+			Title:      "daily",
+			StartDt:    day(1, 9),
+			EndDt:      day(1, 10),
+			Recurrence: &models.Recurrence{Freq: models.FreqDaily, Count: 10},
+			Exceptions: exceptions,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		meeting  models.Meeting
+		from, to time.Time
+		want     []time.Time
+		title    map[int]string
+	}{
+		{
+			name:    "no exceptions",
+			meeting: series(),
+			from:    day(2, 0), to: day(5, 0),
+			want: []time.Time{day(2, 9), day(3, 9), day(4, 9)},
+		},
+		{
+			name:    "cancelled occurrence",
+			meeting: series(models.MeetingException{OriginalStartDt: day(3, 9), Cancelled: true}),
+			from:    day(2, 0), to: day(5, 0),
+			want: []time.Time{day(2, 9), day(4, 9)},
+		},
+		{
+			name:    "modified occurrence keeps series fields",
+			meeting: series(models.MeetingException{OriginalStartDt: day(3, 9), Title: "moved", StartDt: ptr(day(3, 14)), EndDt: ptr(day(3, 15))}),
+			from:    day(2, 0), to: day(5, 0),
+			want:  []time.Time{day(2, 9), day(3, 14), day(4, 9)},
+			title: map[int]string{0: "daily", 1: "moved", 2: "daily"},
+		},
+		{
+			name:    "occurrence moved into window",
+			meeting: series(models.MeetingException{OriginalStartDt: day(8, 9), StartDt: ptr(day(4, 12))}),
+			from:    day(2, 0), to: day(5, 0),
+			want: []time.Time{day(2, 9), day(3, 9), day(4, 9), day(4, 12)},
+		},
+		{
+			name:    "occurrence moved out of window",
+			meeting: series(models.MeetingException{OriginalStartDt: day(3, 9), StartDt: ptr(day(9, 9))}),
+			from:    day(2, 0), to: day(5, 0),
+			want: []time.Time{day(2, 9), day(4, 9)},
+		},
+		{
+			name:    "occurrence moved into window from before",
+			meeting: series(models.MeetingException{OriginalStartDt: day(1, 9), StartDt: ptr(day(6, 9))}),
+			from:    day(5, 0), to: day(7, 0),
+			want: []time.Time{day(5, 9), day(6, 9), day(6, 9)},
+		},
+		{
+			name:    "occurrence beyond count is not revived",
+			meeting: series(models.MeetingException{OriginalStartDt: day(11, 9), StartDt: ptr(day(3, 12))}),
+			from:    day(3, 0), to: day(4, 0),
+			want: []time.Time{day(3, 9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandMeetings([]models.Meeting{tt.meeting}, tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences, want %d", len(got), len(tt.want))
+			}
+			for i, meeting := range got {
+				if !meeting.StartDt.Equal(tt.want[i]) {
+					t.Errorf("occurrence %d: got %v, want %v", i, meeting.StartDt, tt.want[i])
+				}
+				if meeting.Exceptions != nil {
+					t.Errorf("occurrence %d: exceptions should be cleared", i)
+				}
+				if title, ok := tt.title[i]; ok && meeting.Title != title {
+					t.Errorf("occurrence %d: got title %q, want %q", i, meeting.Title, title)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandMeetingsSingle(t *testing.T) {
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	meetings := []models.Meeting{
+		{Title: "before", StartDt: from.Add(-2 * time.Hour), EndDt: from.Add(-time.Hour)},
+		{Title: "spanning", StartDt: from.Add(-time.Hour), EndDt: from.Add(time.Hour)},
+		{Title: "inside", StartDt: from.Add(time.Hour)},
+		{Title: "after", StartDt: to},
+	}
+
+	got := expandMeetings(meetings, from, to)
+	if len(got) != 2 || got[0].Title != "spanning" || got[1].Title != "inside" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestRemapExceptions(t *testing.T) {
+	day := func(d, h int) time.Time {
+		return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	// 2024-01-01(월)부터 매일 9시, 3일은 취소하고 4일은 14시로 옮김
+	daily := &models.Recurrence{Freq: models.FreqDaily, Interval: 1}
+	exceptions := []models.MeetingException{
+		{OriginalStartDt: day(3, 9), Cancelled: true},
+		{OriginalStartDt: day(4, 9), StartDt: ptr(day(4, 14))},
+	}
+
+	tests := []struct {
+		name     string
+		rule     *models.Recurrence
+		newStart time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "start unchanged",
+			rule:     daily,
+			newStart: day(1, 9),
+			want:     []time.Time{day(3, 9), day(4, 9)},
+		},
+		{
+			name:     "start moved by an hour",
+			rule:     daily,
+			newStart: day(1, 10),
+			want:     []time.Time{day(3, 10), day(4, 10)},
+		},
+		{
+			name:     "start moved past an exception",
+			rule:     daily,
+			newStart: day(4, 9),
+			want:     []time.Time{day(6, 9), day(7, 9)},
+		},
+		{
+			name:     "rule no longer generates an occurrence",
+			rule:     &models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"MO", "WE"}},
+			newStart: day(1, 9),
+			want:     []time.Time{day(3, 9)},
+		},
+		{
+			name:     "count ends before exceptions",
+			rule:     &models.Recurrence{Freq: models.FreqDaily, Count: 2},
+			newStart: day(1, 9),
+			want:     []time.Time{},
+		},
+		{
+			name:     "recurrence removed",
+			rule:     nil,
+			newStart: day(1, 9),
+			want:     []time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := remapExceptions(exceptions, tt.rule, day(1, 9), tt.newStart)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d exceptions, want %d", len(got), len(tt.want))
+			}
+			for i, e := range got {
+				if !e.OriginalStartDt.Equal(tt.want[i]) {
+					t.Errorf("exception %d: got %v, want %v", i, e.OriginalStartDt, tt.want[i])
+				}
+			}
+		})
+	}
+
+	// 옮긴 회차의 새 일시는 그대로 두고, 원본 슬라이스는 바꾸지 않음
+	got := remapExceptions(exceptions, daily, day(1, 9), day(1, 10))
+	if !got[1].StartDt.Equal(day(4, 14)) {
+		t.Errorf("moved occurrence: got %v, want %v", got[1].StartDt, day(4, 14))
+	}
+	if !exceptions[0].OriginalStartDt.Equal(day(3, 9)) {
+		t.Errorf("input was modified: %v", exceptions[0].OriginalStartDt)
+	}
+
+	// 예외가 새 회차에 다시 적용되는지 확인
+	meeting := models.Meeting{StartDt: day(1, 10), Recurrence: daily, Exceptions: got}
+	expanded := expandMeetings([]models.Meeting{meeting}, day(3, 0), day(5, 0))
+	if len(expanded) != 1 || !expanded[0].StartDt.Equal(day(4, 14)) {
+		t.Errorf("expanded after remap: got %v", expanded)
+	}
+}
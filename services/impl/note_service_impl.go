@@ -18,11 +18,12 @@ import (
 )
 
 type NoteServiceImpl struct {
-	collection *mongo.Collection
+	collection      *mongo.Collection
+	auditCollection *mongo.Collection
 }
 
-func NewNoteServiceImpl(collection *mongo.Collection) services.NoteService {
-	return &NoteServiceImpl{collection}
+func NewNoteServiceImpl(collection *mongo.Collection, auditCollection *mongo.Collection) services.NoteService {
+	return &NoteServiceImpl{collection, auditCollection}
 }
 
 func (ns *NoteServiceImpl) GetAllNote() ([]models.Note, error) {
@@ -38,7 +39,7 @@ func (ns *NoteServiceImpl) GetAllNote() ([]models.Note, error) {
 		{Key: "as", Value: "author_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{lookupStage}
+	pipeline := mongo.Pipeline{notDeletedStage, lookupStage}
 
 	// query := bson.M{}
 	// results, err := us.collection.Find(ctx, query)
@@ -78,7 +79,7 @@ func (ns *NoteServiceImpl) GetNote(id string) (*models.Note, error) {
 
 	var note *models.Note
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: noteId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: noteId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -125,7 +126,7 @@ func (ns *NoteServiceImpl) GetNoteByUser(id string) ([]models.Note, error) {
 
 	var notes []models.Note
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "author", Value: userId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "author", Value: userId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -159,8 +160,8 @@ func (ns *NoteServiceImpl) GetNoteByUser(id string) ([]models.Note, error) {
 	return notes, nil
 }
 
-func (ns *NoteServiceImpl) CreateNote(dto *dto.NoteCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ns *NoteServiceImpl) CreateNote(ctx context.Context, dto *dto.NoteCreateDTO) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
@@ -190,11 +191,15 @@ func (ns *NoteServiceImpl) CreateNote(dto *dto.NoteCreateDTO) error {
 		}
 	}
 
+	if err := recordChange(ctx, ns.auditCollection, ns.collection, models.AuditResourceNote, note.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (ns *NoteServiceImpl) UpdateNote(id string, dto *dto.NoteUpdateDTO) (*models.Note, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ns *NoteServiceImpl) UpdateNote(ctx context.Context, id string, dto *dto.NoteUpdateDTO) (*models.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	noteId, err := utils.ConvertToObjectId(id)
@@ -207,17 +212,19 @@ func (ns *NoteServiceImpl) UpdateNote(id string, dto *dto.NoteUpdateDTO) (*model
 		"updated_at": time.Now(),
 	}
 
-	filter := bson.M{"_id": noteId}
-	update := bson.M{"$set": note}
+	before := auditSnapshot(ctx, ns.collection, noteId)
+
+	filter := versionFilter(ctx, bson.M{"_id": noteId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": note})
 
 	result := ns.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, ns.collection, noteId, &errors.CustomError{
 				Message:    "노트를 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -235,11 +242,15 @@ func (ns *NoteServiceImpl) UpdateNote(id string, dto *dto.NoteUpdateDTO) (*model
 		}
 	}
 
+	if err := recordChange(ctx, ns.auditCollection, ns.collection, models.AuditResourceNote, noteId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedNote, nil
 }
 
-func (ns *NoteServiceImpl) DeleteNote(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ns *NoteServiceImpl) DeleteNote(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	objID, err := primitive.ObjectIDFromHex(id)
@@ -251,9 +262,11 @@ func (ns *NoteServiceImpl) DeleteNote(id string) error {
 		}
 	}
 
-	filter := bson.M{"_id": objID}
+	before := auditSnapshot(ctx, ns.collection, objID)
 
-	result, err := ns.collection.DeleteOne(ctx, filter)
+	filter := versionFilter(ctx, bson.M{"_id": objID})
+
+	result, err := softDelete(ctx, ns.collection, filter, nil)
 	if err != nil {
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -262,12 +275,16 @@ func (ns *NoteServiceImpl) DeleteNote(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
-		return &errors.CustomError{
+	if result.MatchedCount == 0 {
+		return matchError(ctx, ns.collection, objID, &errors.CustomError{
 			Message:    "노트를 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
-		}
+		})
+	}
+
+	if err := recordAudit(ctx, ns.auditCollection, models.AuditResourceNote, models.AuditActionDelete, objID, before, auditSnapshot(ctx, ns.collection, objID)); err != nil {
+		return err
 	}
 
 	return nil
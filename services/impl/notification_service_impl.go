@@ -0,0 +1,124 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NotificationServiceImpl struct {
+	collection *mongo.Collection
+}
+
+func NewNotificationServiceImpl(collection *mongo.Collection) services.NotificationService {
+	return &NotificationServiceImpl{collection}
+}
+
+func (ns *NotificationServiceImpl) GetNotificationByUser(query *dto.NotificationQueryDTO, userId string) ([]models.Notification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	var notifications []models.Notification
+
+	filter := bson.M{"user": actorId}
+	if query.Unread {
+		filter["read_at"] = bson.M{"$exists": false}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	results, err := ns.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &notifications); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return notifications, nil
+}
+
+func (ns *NotificationServiceImpl) ReadNotification(id string, userId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	notificationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("Notification", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	filter := bson.M{"_id": notificationId, "user": actorId}
+	update := bson.M{"$set": bson.M{"read_at": time.Now()}}
+
+	result, err := ns.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return &errors.CustomError{
+			Message:    "알림을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	return nil
+}
+
+func (ns *NotificationServiceImpl) ReadAllNotification(userId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	filter := bson.M{"user": actorId, "read_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"read_at": time.Now()}}
+
+	if _, err := ns.collection.UpdateMany(ctx, filter, update); err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
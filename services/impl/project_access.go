@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// projectAccess Project에 요구되는 권한 수준
+type projectAccess int
+
+const (
+	projectRead projectAccess = iota
+	projectWrite
+	projectOwner
+	// projectLifecycle 보관/복원/삭제, 보관된 Project에도 허용되는 소유자 권한
+	projectLifecycle
+)
+
+// projectRoleRank Project 역할의 권한 순서
+var projectRoleRank = map[string]int{
+	models.ProjectRoleViewer:     1,
+	models.ProjectRoleMaintainer: 2,
+	models.ProjectRoleOwner:      3,
+}
+
+// checkProjectAccess Project를 조회하여 유저가 요청한 수준의 권한을 가지는지 확인
+func checkProjectAccess(ctx context.Context, collection *mongo.Collection, projectId primitive.ObjectID, userId primitive.ObjectID, access projectAccess) (*models.Project, error) {
+	var project models.Project
+
+	if err := collection.FindOne(ctx, bson.M{"_id": projectId, "deleted_at": nil}).Decode(&project); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Project를 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	var allowed bool
+	switch access {
+	case projectRead:
+		allowed = project.CanRead(userId)
+	case projectWrite:
+		allowed = project.CanWrite(userId)
+	case projectOwner, projectLifecycle:
+		allowed = project.IsOwner(userId)
+	}
+
+	if !allowed {
+		return nil, &errors.CustomError{
+			Message:    "Project 접근 권한 없음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s has no access to project %s", userId.Hex(), projectId.Hex()),
+		}
+	}
+
+	// 보관된 Project는 읽기 전용
+	if project.IsArchived() && (access == projectWrite || access == projectOwner) {
+		return nil, &errors.CustomError{
+			Message:    "보관된 Project는 수정할 수 없음",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("project %s is archived", projectId.Hex()),
+		}
+	}
+
+	return &project, nil
+}
+
+// readableProjectMatch 유저가 조회할 수 있는 Project에 연결되었거나 Project가 없는 문서 조건
+func readableProjectMatch(ctx context.Context, collection *mongo.Collection, userId primitive.ObjectID) (bson.D, error) {
+	filter := bson.M{"deleted_at": nil, "members.user": userId}
+
+	projectIds, err := collection.Distinct(ctx, "_id", filter)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "project", Value: bson.D{{Key: "$exists", Value: false}}}},
+		bson.D{{Key: "project", Value: bson.D{{Key: "$in", Value: projectIds}}}},
+	}}}, nil
+}
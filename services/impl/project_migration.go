@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrateProjectOwners 소유자가 없는 Project에 소유자를 지정, SetDependency에서 서버 시작 시 한 번 실행
+// 멤버 관리 이전에 만든 Project는 생성자 기록이 없으므로 가장 먼저 가입한 관리자를 소유자로 지정하고 기존 멤버는 유지
+// 관리자가 없으면 건너뛰고 다음 시작 시 다시 시도
+func MigrateProjectOwners(projectCollection *mongo.Collection, userCollection *mongo.Collection) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"owner": bson.M{"$exists": false}},
+		bson.M{"owner": primitive.NilObjectID},
+	}}
+
+	projectIds, err := projectCollection.Distinct(ctx, "_id", filter)
+	if err != nil {
+		log.Printf("project owner migration failed: %v", err)
+		return
+	}
+
+	if len(projectIds) == 0 {
+		return
+	}
+
+	var admin models.User
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	if err := userCollection.FindOne(ctx, bson.M{"role": models.UserRoleAdmin, "deactivated_at": bson.M{"$exists": false}}, opts).Decode(&admin); err != nil {
+		log.Printf("project owner migration skipped for %d projects: no admin user (%v)", len(projectIds), err)
+		return
+	}
+
+	now := time.Now()
+	migrated := 0
+
+	for _, value := range projectIds {
+		projectId, ok := value.(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+
+		// 이미 멤버이면 역할을 owner로 올리고, 아니면 owner로 추가
+		update := bumpVersion(bson.M{"$set": bson.M{"owner": admin.ID, "members.$[m].role": models.ProjectRoleOwner, "updated_at": now}})
+		arrayFilters := options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{bson.M{"m.user": admin.ID}}})
+
+		result, err := projectCollection.UpdateOne(ctx, bson.M{"_id": projectId, "members.user": admin.ID}, update, arrayFilters)
+		if err != nil {
+			log.Printf("project owner migration %s failed: %v", projectId.Hex(), err)
+			continue
+		}
+
+		if result.MatchedCount == 0 {
+			member := models.ProjectMember{User: admin.ID, Role: models.ProjectRoleOwner, AddedAt: now}
+			update = bumpVersion(bson.M{"$set": bson.M{"owner": admin.ID, "updated_at": now}, "$push": bson.M{"members": member}})
+			if _, err := projectCollection.UpdateOne(ctx, bson.M{"_id": projectId}, update); err != nil {
+				log.Printf("project owner migration %s failed: %v", projectId.Hex(), err)
+				continue
+			}
+		}
+
+		migrated++
+	}
+
+	log.Printf("project owner migration: assigned %s as owner of %d projects", admin.ID.Hex(), migrated)
+}
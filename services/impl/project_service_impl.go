@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
@@ -18,20 +19,40 @@ import (
 )
 
 type ProjectServiceImpl struct {
-	collection *mongo.Collection
+	collection      *mongo.Collection
+	taskCollection  *mongo.Collection
+	todoCollection  *mongo.Collection
+	auditCollection *mongo.Collection
 }
 
-func NewProjectServiceImpl(collection *mongo.Collection) services.ProjectService {
-	return &ProjectServiceImpl{collection}
+// Project 삭제 방식
+const (
+	ProjectDeleteRestrict = "restrict"
+	ProjectDeleteCascade  = "cascade"
+)
+
+func NewProjectServiceImpl(collection *mongo.Collection, taskCollection *mongo.Collection, todoCollection *mongo.Collection, auditCollection *mongo.Collection) services.ProjectService {
+	return &ProjectServiceImpl{collection, taskCollection, todoCollection, auditCollection}
 }
 
-func (ps *ProjectServiceImpl) GetAllProject() ([]models.Project, error) {
+func (ps *ProjectServiceImpl) GetAllProject(query *dto.ProjectQueryDTO, userId string) ([]models.Project, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	var projects []models.Project
 
-	results, err := ps.collection.Find(ctx, bson.M{})
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	// 상세 조회와 같이 멤버인 Project만 반환
+	filter := bson.M{"deleted_at": nil, "members.user": actorId}
+	if !query.IncludeArchived {
+		filter["archived_at"] = bson.M{"$exists": false}
+	}
+
+	results, err := ps.collection.Find(ctx, filter)
 
 	if err != nil {
 		return nil, &errors.CustomError{
@@ -54,15 +75,49 @@ func (ps *ProjectServiceImpl) GetAllProject() ([]models.Project, error) {
 	return projects, nil
 }
 
-func (ps *ProjectServiceImpl) CreateProject(dto *dto.ProjectCreateDTO) error {
+func (ps *ProjectServiceImpl) GetProject(id string, userId string) (*models.ProjectDetail, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectRead)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := projectStats(ctx, ps.taskCollection, ps.todoCollection, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ProjectDetail{Project: *project, Stats: *stats}, nil
+}
+
+func (ps *ProjectServiceImpl) CreateProject(ctx context.Context, dto *dto.ProjectCreateDTO, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
 	fmt.Printf("dto: %+v", dto)
 
+	ownerId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
 	project := models.Project{
 		ID:        primitive.NewObjectID(),
 		Name:      dto.Name,
+		Owner:     ownerId,
+		Members:   []models.ProjectMember{{User: ownerId, Role: models.ProjectRoleOwner, AddedAt: time.Now()}},
 		StartDt:   dto.StartDt,
 		EndDt:     dto.EndDt,
 		CreatedAt: time.Now(),
@@ -71,7 +126,7 @@ func (ps *ProjectServiceImpl) CreateProject(dto *dto.ProjectCreateDTO) error {
 
 	fmt.Printf("project: %+v", project)
 
-	_, err := ps.collection.InsertOne(ctx, project)
+	_, err = ps.collection.InsertOne(ctx, project)
 
 	if err != nil {
 		return &errors.CustomError{
@@ -81,11 +136,15 @@ func (ps *ProjectServiceImpl) CreateProject(dto *dto.ProjectCreateDTO) error {
 		}
 	}
 
+	if err := recordChange(ctx, ps.auditCollection, ps.collection, models.AuditResourceProject, project.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (ps *ProjectServiceImpl) UpdateProject(id string, dto *dto.ProjectUpdateDTO) (*models.Project, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ps *ProjectServiceImpl) UpdateProject(ctx context.Context, id string, dto *dto.ProjectUpdateDTO, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	projectId, err := utils.ConvertToObjectId(id)
@@ -93,6 +152,20 @@ func (ps *ProjectServiceImpl) UpdateProject(id string, dto *dto.ProjectUpdateDTO
 		return nil, utils.ConvertError("Project", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	current, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ps.validateProjectPeriod(ctx, current, dto); err != nil {
+		return nil, err
+	}
+
 	project := bson.M{
 		"updated_at": time.Now(),
 	}
@@ -109,19 +182,21 @@ func (ps *ProjectServiceImpl) UpdateProject(id string, dto *dto.ProjectUpdateDTO
 		project["end_dt"] = dto.EndDt
 	}
 
-	filter := bson.M{"_id": projectId}
-	update := bson.M{"$set": project}
+	before := auditSnapshot(ctx, ps.collection, projectId)
+
+	filter := versionFilter(ctx, bson.M{"_id": projectId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": project})
 
 	fmt.Printf("project: %+v", project)
 
 	result := ps.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, ps.collection, projectId, &errors.CustomError{
 				Message:    "Project를 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -139,11 +214,15 @@ func (ps *ProjectServiceImpl) UpdateProject(id string, dto *dto.ProjectUpdateDTO
 		}
 	}
 
+	if err := recordChange(ctx, ps.auditCollection, ps.collection, models.AuditResourceProject, projectId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedProject, nil
 }
 
-func (ps *ProjectServiceImpl) DeleteProject(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ps *ProjectServiceImpl) DeleteProject(ctx context.Context, id string, mode string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	projectId, err := utils.ConvertToObjectId(id)
@@ -151,10 +230,679 @@ func (ps *ProjectServiceImpl) DeleteProject(id string) error {
 		return utils.ConvertError("Project", err)
 	}
 
-	filter := bson.M{"_id": projectId}
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	if mode == "" {
+		mode = ProjectDeleteRestrict
+	}
+
+	if mode != ProjectDeleteRestrict && mode != ProjectDeleteCascade {
+		return &errors.CustomError{
+			Message:    "유효하지 않은 삭제 방식",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid delete mode: %s", mode),
+		}
+	}
+
+	if _, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectLifecycle); err != nil {
+		return err
+	}
+
+	before := auditSnapshot(ctx, ps.collection, projectId)
+
+	if mode == ProjectDeleteRestrict {
+		err = ps.deleteProjectRestrict(ctx, projectId)
+	} else {
+		err = ps.deleteProjectCascade(ctx, projectId)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := recordAudit(ctx, ps.auditCollection, models.AuditResourceProject, models.AuditActionDelete, projectId, before, auditSnapshot(ctx, ps.collection, projectId)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *ProjectServiceImpl) ArchiveProject(ctx context.Context, id string, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectLifecycle)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.IsArchived() {
+		return project, nil
+	}
+
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{"archived_at": time.Now(), "updated_at": time.Now()}}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) UnarchiveProject(ctx context.Context, id string, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectLifecycle)
+	if err != nil {
+		return nil, err
+	}
+
+	if !project.IsArchived() {
+		return project, nil
+	}
+
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	update := bson.M{"$unset": bson.M{"archived_at": ""}, "$set": bson.M{"updated_at": time.Now()}}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) AddProjectMember(ctx context.Context, id string, dto *dto.ProjectMemberDTO, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	memberId, err := utils.ConvertToObjectId(dto.User)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if memberId.IsZero() {
+		return nil, &errors.CustomError{
+			Message:    "추가할 멤버가 지정되지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("user is required"),
+		}
+	}
 
-	result, err := ps.collection.DeleteOne(ctx, filter)
+	// owner는 Project 생성 시에만 지정되며 멤버 추가로는 maintainer, viewer만 부여
+	if dto.Role != models.ProjectRoleMaintainer && dto.Role != models.ProjectRoleViewer {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 Project 역할",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid project role: %s", dto.Role),
+		}
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectOwner)
 	if err != nil {
+		return nil, err
+	}
+
+	if project.MemberRole(memberId) == models.ProjectRoleOwner {
+		return nil, &errors.CustomError{
+			Message:    "Project 소유자의 역할은 변경할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("user %s is the project owner", dto.User),
+		}
+	}
+
+	// 이미 멤버이면 역할만 변경
+	var update bson.M
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	if project.MemberRole(memberId) != "" {
+		filter["members.user"] = memberId
+		update = bson.M{"$set": bson.M{"members.$.role": dto.Role, "updated_at": time.Now()}}
+	} else {
+		member := models.ProjectMember{User: memberId, Role: dto.Role, AddedAt: time.Now()}
+		update = bson.M{"$push": bson.M{"members": member}, "$set": bson.M{"updated_at": time.Now()}}
+	}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) RemoveProjectMember(ctx context.Context, id string, memberId string, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	memberObjId, err := utils.ConvertToObjectId(memberId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	switch project.MemberRole(memberObjId) {
+	case "":
+		return nil, &errors.CustomError{
+			Message:    "Project 멤버를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	case models.ProjectRoleOwner:
+		return nil, &errors.CustomError{
+			Message:    "Project 소유자는 제거할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("user %s is the project owner", memberId),
+		}
+	}
+
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	update := bson.M{"$pull": bson.M{"members": bson.M{"user": memberObjId}}, "$set": bson.M{"updated_at": time.Now()}}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) AddProjectMilestone(ctx context.Context, id string, dto *dto.ProjectMilestoneDTO, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if dto.Name == "" || dto.DueDt.IsZero() {
+		return nil, &errors.CustomError{
+			Message:    "마일스톤 이름과 마감일이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("name and due_dt are required"),
+		}
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMilestoneDueDt(project, dto.DueDt); err != nil {
+		return nil, err
+	}
+
+	tasks, err := ps.milestoneTasks(ctx, projectId, dto.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone := models.Milestone{
+		ID:    primitive.NewObjectID(),
+		Name:  dto.Name,
+		DueDt: dto.DueDt,
+		Tasks: tasks,
+	}
+
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	update := bson.M{"$push": bson.M{"milestones": milestone}, "$set": bson.M{"updated_at": time.Now()}}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) UpdateProjectMilestone(ctx context.Context, id string, milestoneId string, dto *dto.ProjectMilestoneDTO, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	milestoneObjId, err := utils.ConvertToObjectId(milestoneId)
+	if err != nil {
+		return nil, utils.ConvertError("Milestone", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if findMilestone(project, milestoneObjId) == nil {
+		return nil, &errors.CustomError{
+			Message:    "마일스톤을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	milestone := bson.M{
+		"updated_at": time.Now(),
+	}
+
+	if dto.Name != "" {
+		milestone["milestones.$.name"] = dto.Name
+	}
+
+	if !dto.DueDt.IsZero() {
+		if err := validateMilestoneDueDt(project, dto.DueDt); err != nil {
+			return nil, err
+		}
+		milestone["milestones.$.due_dt"] = dto.DueDt
+	}
+
+	if dto.Tasks != nil {
+		if milestone["milestones.$.tasks"], err = ps.milestoneTasks(ctx, projectId, dto.Tasks); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := bson.M{"_id": projectId, "milestones._id": milestoneObjId, "deleted_at": nil}
+	update := bson.M{"$set": milestone}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) DeleteProjectMilestone(ctx context.Context, id string, milestoneId string, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	milestoneObjId, err := utils.ConvertToObjectId(milestoneId)
+	if err != nil {
+		return nil, utils.ConvertError("Milestone", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if findMilestone(project, milestoneObjId) == nil {
+		return nil, &errors.CustomError{
+			Message:    "마일스톤을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	filter := bson.M{"_id": projectId, "deleted_at": nil}
+	update := bson.M{"$pull": bson.M{"milestones": bson.M{"_id": milestoneObjId}}, "$set": bson.M{"updated_at": time.Now()}}
+
+	return ps.updateProject(ctx, projectId, filter, update)
+}
+
+func (ps *ProjectServiceImpl) GetProjectTimeline(id string, userId string) (*models.ProjectTimeline, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectRead)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []models.ProjectTask
+
+	opts := options.Find().SetSort(bson.D{{Key: "start_dt", Value: 1}, {Key: "created_at", Value: 1}})
+	results, err := ps.taskCollection.Find(ctx, bson.M{"project": projectId, "deleted_at": nil}, opts)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &tasks); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return buildProjectTimeline(project, tasks), nil
+}
+
+func (ps *ProjectServiceImpl) GetProjectCriticalPath(id string, userId string) (*models.ProjectCriticalPath, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, ps.collection, projectId, actorId, projectRead)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := projectTasks(ctx, ps.taskCollection, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return criticalPath(project, tasks)
+}
+
+// buildProjectTimeline Project, 마일스톤, Task를 Gantt 항목으로 변환
+// 마일스톤에 연결된 Task는 마일스톤을, 나머지 Task는 Project를 부모로 가짐
+func buildProjectTimeline(project *models.Project, tasks []models.ProjectTask) *models.ProjectTimeline {
+	projectItem := models.TimelineItem{
+		ID:   project.ID,
+		Type: models.TimelineTypeProject,
+		Name: project.Name,
+	}
+	if !project.StartDt.IsZero() {
+		projectItem.Start = &project.StartDt
+	}
+	if !project.EndDt.IsZero() {
+		projectItem.End = &project.EndDt
+	}
+
+	items := []models.TimelineItem{projectItem}
+
+	milestones := append([]models.Milestone{}, project.Milestones...)
+	sort.SliceStable(milestones, func(i, j int) bool {
+		return milestones[i].DueDt.Before(milestones[j].DueDt)
+	})
+
+	parents := map[primitive.ObjectID]primitive.ObjectID{}
+	for i := range milestones {
+		milestone := &milestones[i]
+		items = append(items, models.TimelineItem{
+			ID:     milestone.ID,
+			Type:   models.TimelineTypeMilestone,
+			Name:   milestone.Name,
+			Start:  &milestone.DueDt,
+			End:    &milestone.DueDt,
+			Parent: &project.ID,
+		})
+		for _, taskId := range milestone.Tasks {
+			if _, ok := parents[taskId]; !ok {
+				parents[taskId] = milestone.ID
+			}
+		}
+	}
+
+	for _, task := range tasks {
+		parent := project.ID
+		if milestoneId, ok := parents[task.ID]; ok {
+			parent = milestoneId
+		}
+		items = append(items, models.TimelineItem{
+			ID:     task.ID,
+			Type:   models.TimelineTypeTask,
+			Name:   task.TaskDescription,
+			Start:  task.StartDt,
+			End:    task.EndDt,
+			Parent: &parent,
+			Status: task.Status,
+		})
+	}
+
+	return &models.ProjectTimeline{Project: project.ID, Items: items}
+}
+
+// findMilestone Project에서 마일스톤을 찾아 반환, 없으면 nil
+func findMilestone(project *models.Project, milestoneId primitive.ObjectID) *models.Milestone {
+	for i := range project.Milestones {
+		if project.Milestones[i].ID == milestoneId {
+			return &project.Milestones[i]
+		}
+	}
+	return nil
+}
+
+// validateProjectPeriod 바뀐 Project 기간이 시작 <= 종료이고 기존 마일스톤과 Task 일정을 모두 포함하는지 확인
+func (ps *ProjectServiceImpl) validateProjectPeriod(ctx context.Context, project *models.Project, dto *dto.ProjectUpdateDTO) error {
+	if dto.StartDt.IsZero() && dto.EndDt.IsZero() {
+		return nil
+	}
+
+	period := *project
+	if !dto.StartDt.IsZero() {
+		period.StartDt = dto.StartDt
+	}
+	if !dto.EndDt.IsZero() {
+		period.EndDt = dto.EndDt
+	}
+
+	if !period.StartDt.IsZero() && !period.EndDt.IsZero() && period.EndDt.Before(period.StartDt) {
+		return &errors.CustomError{
+			Message:    "Project 종료 일시가 시작 일시보다 빠름",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("end_dt %s is before start_dt %s", period.EndDt, period.StartDt),
+		}
+	}
+
+	for _, milestone := range project.Milestones {
+		if validateMilestoneDueDt(&period, milestone.DueDt) != nil {
+			return &errors.CustomError{
+				Message:    "Project 기간을 벗어나는 마일스톤이 있음",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("milestone %s due %s is outside of project period %s ~ %s", milestone.ID.Hex(), milestone.DueDt, period.StartDt, period.EndDt),
+			}
+		}
+	}
+
+	outside := bson.A{}
+	if !period.StartDt.IsZero() {
+		outside = append(outside, bson.M{"start_dt": bson.M{"$lt": period.StartDt}}, bson.M{"end_dt": bson.M{"$lt": period.StartDt}})
+	}
+	if !period.EndDt.IsZero() {
+		outside = append(outside, bson.M{"start_dt": bson.M{"$gt": period.EndDt}}, bson.M{"end_dt": bson.M{"$gt": period.EndDt}})
+	}
+	if len(outside) == 0 {
+		return nil
+	}
+
+	count, err := ps.taskCollection.CountDocuments(ctx, bson.M{"project": project.ID, "deleted_at": nil, "$or": outside})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count > 0 {
+		return &errors.CustomError{
+			Message:    "Project 기간을 벗어나는 Task 일정이 있음",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("%d tasks are outside of project period %s ~ %s", count, period.StartDt, period.EndDt),
+		}
+	}
+
+	return nil
+}
+
+// validateMilestoneDueDt 마일스톤 마감일이 Project 기간 안에 있는지 확인
+func validateMilestoneDueDt(project *models.Project, dueDt time.Time) error {
+	if (!project.StartDt.IsZero() && dueDt.Before(project.StartDt)) || (!project.EndDt.IsZero() && dueDt.After(project.EndDt)) {
+		return &errors.CustomError{
+			Message:    "마일스톤 마감일이 Project 기간을 벗어남",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("%s is outside of project period %s ~ %s", dueDt, project.StartDt, project.EndDt),
+		}
+	}
+	return nil
+}
+
+// milestoneTasks 마일스톤에 연결할 Task ID를 변환하고 모두 같은 Project의 Task인지 확인
+func (ps *ProjectServiceImpl) milestoneTasks(ctx context.Context, projectId primitive.ObjectID, ids []string) ([]primitive.ObjectID, error) {
+	tasks := []primitive.ObjectID{}
+	seen := map[primitive.ObjectID]bool{}
+
+	for _, id := range ids {
+		taskId, err := utils.ConvertToObjectId(id)
+		if err != nil {
+			return nil, utils.ConvertError("ProjectTask", err)
+		}
+		if taskId.IsZero() || seen[taskId] {
+			continue
+		}
+		seen[taskId] = true
+		tasks = append(tasks, taskId)
+	}
+
+	if len(tasks) == 0 {
+		return tasks, nil
+	}
+
+	count, err := ps.taskCollection.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": tasks}, "project": projectId, "deleted_at": nil})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if int(count) != len(tasks) {
+		return nil, &errors.CustomError{
+			Message:    "마일스톤에는 같은 Project의 Task만 연결할 수 있음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("some tasks do not belong to project %s", projectId.Hex()),
+		}
+	}
+
+	return tasks, nil
+}
+
+// deleteProjectRestrict 휴지통에 있지 않은 하위 Task/Todo가 없을 때만 Project를 삭제
+func (ps *ProjectServiceImpl) deleteProjectRestrict(ctx context.Context, projectId primitive.ObjectID) error {
+	filter := bson.M{"project": projectId, "deleted_at": nil}
+
+	for _, collection := range []*mongo.Collection{ps.taskCollection, ps.todoCollection} {
+		count, err := collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if count > 0 {
+			return &errors.CustomError{
+				Message:    "Project에 속한 Task 또는 Todo가 있어 삭제할 수 없음",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("project %s has %d documents in %s", projectId.Hex(), count, collection.Name()),
+			}
+		}
+	}
+
+	return ps.deleteProjectDocument(ctx, projectId)
+}
+
+// deleteProjectCascade 트랜잭션 안에서 하위 Task/Todo와 Project를 함께 휴지통으로 옮김
+// 하위 문서에는 deleted_with를 남겨 Project를 복원할 때 함께 복원
+func (ps *ProjectServiceImpl) deleteProjectCascade(ctx context.Context, projectId primitive.ObjectID) error {
+	session, err := ps.collection.Database().Client().StartSession()
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer session.EndSession(ctx)
+
+	childFilter := bson.M{"project": projectId, "deleted_at": nil}
+	batches := []*auditBatch{
+		newAuditBatch(ctx, ps.taskCollection, models.AuditResourceProjectTask, childFilter),
+		newAuditBatch(ctx, ps.todoCollection, models.AuditResourceTodo, childFilter),
+	}
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		filter := bson.M{"project": projectId}
+
+		if _, err := softDelete(sessCtx, ps.taskCollection, filter, &projectId); err != nil {
+			return nil, err
+		}
+
+		if _, err := softDelete(sessCtx, ps.todoCollection, filter, &projectId); err != nil {
+			return nil, err
+		}
+
+		return nil, ps.deleteProjectDocument(sessCtx, projectId)
+	})
+
+	if err != nil {
+		if customErr, ok := err.(*errors.CustomError); ok {
+			return customErr
+		}
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
 			StatusCode: http.StatusInternalServerError,
@@ -162,13 +910,69 @@ func (ps *ProjectServiceImpl) DeleteProject(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
+	for _, batch := range batches {
+		if err := batch.record(ctx, ps.auditCollection); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteProjectDocument Project 문서를 휴지통으로 옮김
+func (ps *ProjectServiceImpl) deleteProjectDocument(ctx context.Context, projectId primitive.ObjectID) error {
+	result, err := softDelete(ctx, ps.collection, versionFilter(ctx, bson.M{"_id": projectId}), nil)
+	if err != nil {
 		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.MatchedCount == 0 {
+		return matchError(ctx, ps.collection, projectId, &errors.CustomError{
 			Message:    "Project를 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
-		}
+		})
 	}
 
 	return nil
 }
+
+// updateProject 멤버/마일스톤/보관 상태 변경을 If-Match 조건으로 반영하고 감사 로그를 남긴 뒤 변경된 Project를 반환
+func (ps *ProjectServiceImpl) updateProject(ctx context.Context, projectId primitive.ObjectID, filter bson.M, update bson.M) (*models.Project, error) {
+	before := auditSnapshot(ctx, ps.collection, projectId)
+
+	result := ps.collection.FindOneAndUpdate(ctx, versionFilter(ctx, filter), bumpVersion(update), options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, ps.collection, projectId, &errors.CustomError{
+				Message:    "Project를 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        result.Err(),
+			})
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        result.Err(),
+		}
+	}
+
+	var updatedProject *models.Project
+	if err := result.Decode(&updatedProject); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "결과 디코딩 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := recordChange(ctx, ps.auditCollection, ps.collection, models.AuditResourceProject, projectId, before); err != nil {
+		return nil, err
+	}
+
+	return updatedProject, nil
+}
@@ -0,0 +1,174 @@
+package impl
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type statusCount struct {
+	Status string `bson:"_id"`
+	Count  int    `bson:"count"`
+}
+
+type overdueCount []struct {
+	Count int `bson:"count"`
+}
+
+type taskStatsResult struct {
+	ByStatus []statusCount            `bson:"by_status"`
+	Workload []models.ManagerWorkload `bson:"workload"`
+	Overdue  overdueCount             `bson:"overdue"`
+}
+
+type todoStatsResult struct {
+	ByStatus []statusCount `bson:"by_status"`
+	Overdue  overdueCount  `bson:"overdue"`
+}
+
+// byStatusFacet 상태별 개수를 세는 $facet 하위 파이프라인
+func byStatusFacet() bson.A {
+	return bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$status"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+}
+
+// overdueFacet 완료되지 않았고 종료 일시가 지난 문서 수를 세는 $facet 하위 파이프라인
+func overdueFacet(now time.Time) bson.A {
+	return bson.A{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "status", Value: bson.D{{Key: "$ne", Value: models.TaskStatusDone}}},
+			{Key: "end_dt", Value: bson.D{{Key: "$gt", Value: time.Time{}}, {Key: "$lt", Value: now}}},
+		}}},
+		bson.D{{Key: "$count", Value: "count"}},
+	}
+}
+
+// total $count 결과가 없으면 0
+func (o overdueCount) total() int {
+	if len(o) == 0 {
+		return 0
+	}
+	return o[0].Count
+}
+
+// projectStats Project에 속한 Task와 Todo를 집계하여 진행 현황을 계산
+func projectStats(ctx context.Context, taskCollection *mongo.Collection, todoCollection *mongo.Collection, project *models.Project) (*models.ProjectStats, error) {
+	now := time.Now()
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "project", Value: project.ID}, {Key: "deleted_at", Value: nil}}}}
+
+	taskFacetStage := bson.D{{Key: "$facet", Value: bson.D{
+		{Key: "by_status", Value: byStatusFacet()},
+		{Key: "overdue", Value: overdueFacet(now)},
+		{Key: "workload", Value: bson.A{
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$manager"},
+				{Key: "task_count", Value: bson.D{{Key: "$sum", Value: 1}}},
+				{Key: "open_task_count", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$ne", Value: bson.A{"$status", models.TaskStatusDone}}}, 1, 0,
+				}}}}}},
+			}}},
+			bson.D{{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "users"},
+				{Key: "localField", Value: "_id"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "manager_info"},
+			}}},
+			bson.D{{Key: "$addFields", Value: bson.D{
+				{Key: "user_name", Value: bson.D{{Key: "$first", Value: "$manager_info.user_name"}}},
+			}}},
+			bson.D{{Key: "$sort", Value: bson.D{{Key: "open_task_count", Value: -1}}}},
+		}},
+	}}}
+
+	var taskStats taskStatsResult
+	if err := aggregateOne(ctx, taskCollection, mongo.Pipeline{matchStage, taskFacetStage}, &taskStats); err != nil {
+		return nil, err
+	}
+
+	todoFacetStage := bson.D{{Key: "$facet", Value: bson.D{
+		{Key: "by_status", Value: byStatusFacet()},
+		{Key: "overdue", Value: overdueFacet(now)},
+	}}}
+
+	var todoStats todoStatsResult
+	if err := aggregateOne(ctx, todoCollection, mongo.Pipeline{matchStage, todoFacetStage}, &todoStats); err != nil {
+		return nil, err
+	}
+
+	stats := &models.ProjectStats{
+		TaskStatusCounts: map[string]int{},
+		TodoStatusCounts: map[string]int{},
+		Workload:         taskStats.Workload,
+	}
+
+	done := 0
+
+	for _, status := range taskStats.ByStatus {
+		stats.TaskStatusCounts[status.Status] += status.Count
+		stats.TaskCount += status.Count
+		if status.Status == models.TaskStatusDone {
+			done += status.Count
+		}
+	}
+
+	for _, status := range todoStats.ByStatus {
+		stats.TodoStatusCounts[status.Status] += status.Count
+		stats.TodoCount += status.Count
+		if status.Status == models.TodoStatusDone {
+			done += status.Count
+		}
+	}
+
+	if total := stats.TaskCount + stats.TodoCount; total > 0 {
+		stats.PercentComplete = math.Round(float64(done)/float64(total)*1000) / 10
+	}
+
+	stats.OverdueCount = taskStats.Overdue.total() + todoStats.Overdue.total()
+
+	if !project.EndDt.IsZero() {
+		days := int(math.Ceil(project.EndDt.Sub(now).Hours() / 24))
+		stats.DaysRemaining = &days
+	}
+
+	if stats.Workload == nil {
+		stats.Workload = []models.ManagerWorkload{}
+	}
+
+	return stats, nil
+}
+
+// aggregateOne 결과가 하나인 aggregation($facet 등)을 실행하여 디코딩
+func aggregateOne(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline, result interface{}) error {
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer cursor.Close(ctx)
+
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(result); err != nil {
+			return &errors.CustomError{
+				Message:    "결과 디코딩 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+	}
+
+	return nil
+}
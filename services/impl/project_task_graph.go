@@ -0,0 +1,236 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// projectTasks Project에 속한 Task를 선행 관계 계산에 필요한 필드만 조회
+func projectTasks(ctx context.Context, collection *mongo.Collection, projectId primitive.ObjectID) ([]models.ProjectTask, error) {
+	var tasks []models.ProjectTask
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	results, err := collection.Find(ctx, bson.M{"project": projectId, "deleted_at": nil}, opts)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &tasks); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return tasks, nil
+}
+
+// convertBlockedBy 선행 Task ID를 변환하고 중복과 빈 값을 제거
+func convertBlockedBy(ids []string) ([]primitive.ObjectID, error) {
+	blockedBy := []primitive.ObjectID{}
+	seen := map[primitive.ObjectID]bool{}
+
+	for _, id := range ids {
+		blockerId, err := utils.ConvertToObjectId(id)
+		if err != nil {
+			return nil, utils.ConvertError("ProjectTask", err)
+		}
+		if blockerId.IsZero() || seen[blockerId] {
+			continue
+		}
+		seen[blockerId] = true
+		blockedBy = append(blockedBy, blockerId)
+	}
+
+	return blockedBy, nil
+}
+
+// validateBlockedBy 선행 Task가 모두 같은 Project에 있고, taskId에 연결했을 때 순환이 생기지 않는지 확인
+func validateBlockedBy(tasks []models.ProjectTask, taskId primitive.ObjectID, blockedBy []primitive.ObjectID) error {
+	graph := map[primitive.ObjectID][]primitive.ObjectID{}
+	for _, task := range tasks {
+		graph[task.ID] = task.BlockedBy
+	}
+
+	for _, blockerId := range blockedBy {
+		if blockerId == taskId {
+			return &errors.CustomError{
+				Message:    "Task는 자기 자신을 선행 Task로 지정할 수 없음",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("task %s blocks itself", taskId.Hex()),
+			}
+		}
+		if _, ok := graph[blockerId]; !ok {
+			return &errors.CustomError{
+				Message:    "선행 Task는 같은 Project의 Task만 지정할 수 있음",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("task %s does not belong to the project", blockerId.Hex()),
+			}
+		}
+	}
+
+	graph[taskId] = blockedBy
+
+	// 선행 Task를 따라가다 자기 자신에 도달하면 순환
+	visited := map[primitive.ObjectID]bool{}
+	var reaches func(id primitive.ObjectID) bool
+	reaches = func(id primitive.ObjectID) bool {
+		if id == taskId {
+			return true
+		}
+		if visited[id] {
+			return false
+		}
+		visited[id] = true
+		for _, next := range graph[id] {
+			if reaches(next) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, blockerId := range blockedBy {
+		if reaches(blockerId) {
+			return &errors.CustomError{
+				Message:    "선행 Task 관계에 순환이 생김",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("blocked_by of task %s creates a cycle", taskId.Hex()),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBlockersDone 선행 Task가 모두 완료되었는지 확인
+func validateBlockersDone(tasks []models.ProjectTask, blockedBy []primitive.ObjectID) error {
+	blockers := map[primitive.ObjectID]bool{}
+	for _, blockerId := range blockedBy {
+		blockers[blockerId] = true
+	}
+
+	for _, task := range tasks {
+		if blockers[task.ID] && task.Status != models.TaskStatusDone {
+			return &errors.CustomError{
+				Message:    "완료되지 않은 선행 Task가 있음",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("blocker %s is %s", task.ID.Hex(), task.Status),
+			}
+		}
+	}
+
+	return nil
+}
+
+// criticalPath 선행 관계를 따라 소요 시간 합이 가장 긴 경로를 계산
+// 소요 시간은 Task의 start_dt ~ end_dt이며 일정이 없는 Task는 0으로 봄
+func criticalPath(project *models.Project, tasks []models.ProjectTask) (*models.ProjectCriticalPath, error) {
+	byId := map[primitive.ObjectID]*models.ProjectTask{}
+	for i := range tasks {
+		byId[tasks[i].ID] = &tasks[i]
+	}
+
+	// 다른 Project의 Task나 삭제된 Task를 가리키는 관계는 무시
+	indegree := map[primitive.ObjectID]int{}
+	dependents := map[primitive.ObjectID][]primitive.ObjectID{}
+	for _, task := range tasks {
+		for _, blockerId := range task.BlockedBy {
+			if _, ok := byId[blockerId]; ok {
+				indegree[task.ID]++
+				dependents[blockerId] = append(dependents[blockerId], task.ID)
+			}
+		}
+	}
+
+	queue := []primitive.ObjectID{}
+	for _, task := range tasks {
+		if indegree[task.ID] == 0 {
+			queue = append(queue, task.ID)
+		}
+	}
+
+	start := map[primitive.ObjectID]float64{}
+	finish := map[primitive.ObjectID]float64{}
+	previous := map[primitive.ObjectID]primitive.ObjectID{}
+	visited := 0
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		visited++
+
+		finish[id] = start[id] + taskDurationHours(byId[id])
+
+		for _, next := range dependents[id] {
+			if finish[id] > start[next] || previous[next].IsZero() {
+				start[next] = finish[id]
+				previous[next] = id
+			}
+			indegree[next]--
+			if indegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if visited != len(tasks) {
+		return nil, &errors.CustomError{
+			Message:    "선행 Task 관계에 순환이 있음",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("project %s has a dependency cycle", project.ID.Hex()),
+		}
+	}
+
+	result := &models.ProjectCriticalPath{Project: project.ID, Tasks: []models.CriticalPathTask{}}
+
+	var last primitive.ObjectID
+	for _, task := range tasks {
+		if last.IsZero() || finish[task.ID] > finish[last] {
+			last = task.ID
+		}
+	}
+
+	for id := last; !id.IsZero(); id = previous[id] {
+		task := byId[id]
+		result.Tasks = append([]models.CriticalPathTask{{
+			ID:            task.ID,
+			Name:          task.TaskDescription,
+			Status:        task.Status,
+			DurationHours: taskDurationHours(task),
+			StartHours:    start[id],
+			FinishHours:   finish[id],
+		}}, result.Tasks...)
+	}
+
+	if !last.IsZero() {
+		result.TotalHours = finish[last]
+	}
+
+	return result, nil
+}
+
+// taskDurationHours Task 일정의 길이(시간), 일정이 없으면 0
+func taskDurationHours(task *models.ProjectTask) float64 {
+	if task.StartDt == nil || task.EndDt == nil {
+		return 0
+	}
+	return task.EndDt.Sub(*task.StartDt).Hours()
+}
@@ -0,0 +1,141 @@
+package impl
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// graphTask hours 시간짜리 일정을 가진 Task, hours가 0이면 일정 없음
+func graphTask(name string, hours int, blockedBy ...primitive.ObjectID) models.ProjectTask {
+	task := models.ProjectTask{ID: primitive.NewObjectID(), TaskDescription: name, BlockedBy: blockedBy}
+	if hours > 0 {
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(time.Duration(hours) * time.Hour)
+		task.StartDt, task.EndDt = &start, &end
+	}
+	return task
+}
+
+func statusOf(err error) int {
+	if customErr, ok := err.(*errors.CustomError); ok {
+		return customErr.StatusCode
+	}
+	return 0
+}
+
+func TestValidateBlockedBy(t *testing.T) {
+	a := graphTask("a", 1)
+	b := graphTask("b", 1, a.ID)
+	c := graphTask("c", 1, b.ID)
+	d := graphTask("d", 1)
+	tasks := []models.ProjectTask{a, b, c, d}
+
+	tests := []struct {
+		name      string
+		taskId    primitive.ObjectID
+		blockedBy []primitive.ObjectID
+		want      int
+	}{
+		{name: "self block", taskId: a.ID, blockedBy: []primitive.ObjectID{a.ID}, want: http.StatusBadRequest},
+		{name: "direct cycle", taskId: a.ID, blockedBy: []primitive.ObjectID{b.ID}, want: http.StatusConflict},
+		{name: "indirect cycle", taskId: a.ID, blockedBy: []primitive.ObjectID{c.ID}, want: http.StatusConflict},
+		{name: "other project", taskId: d.ID, blockedBy: []primitive.ObjectID{primitive.NewObjectID()}, want: http.StatusBadRequest},
+		{name: "diamond", taskId: d.ID, blockedBy: []primitive.ObjectID{b.ID, c.ID}},
+		{name: "new task", taskId: primitive.NewObjectID(), blockedBy: []primitive.ObjectID{c.ID, d.ID}},
+		{name: "clear blockers", taskId: c.ID, blockedBy: []primitive.ObjectID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlockedBy(tasks, tt.taskId, tt.blockedBy)
+			if got := statusOf(err); got != tt.want {
+				t.Errorf("got status %d (%v), want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	project := &models.Project{ID: primitive.NewObjectID()}
+
+	// 다이아몬드: a -> (b 5시간 | c 2시간) -> d
+	a := graphTask("a", 1)
+	b := graphTask("b", 5, a.ID)
+	c := graphTask("c", 2, a.ID)
+	d := graphTask("d", 3, b.ID, c.ID)
+
+	// 다이아몬드와 연결되지 않은 Task
+	e := graphTask("e", 4)
+	f := graphTask("f", 1, e.ID)
+
+	// 다른 Project의 Task를 가리키는 관계는 무시
+	g := graphTask("g", 1, primitive.NewObjectID())
+
+	tests := []struct {
+		name      string
+		tasks     []models.ProjectTask
+		wantPath  []string
+		wantTotal float64
+	}{
+		{name: "empty", tasks: nil, wantPath: []string{}, wantTotal: 0},
+		{name: "single", tasks: []models.ProjectTask{a}, wantPath: []string{"a"}, wantTotal: 1},
+		{name: "diamond takes the longer branch", tasks: []models.ProjectTask{a, b, c, d}, wantPath: []string{"a", "b", "d"}, wantTotal: 9},
+		{name: "order of tasks does not matter", tasks: []models.ProjectTask{d, c, b, a}, wantPath: []string{"a", "b", "d"}, wantTotal: 9},
+		{name: "disconnected tasks", tasks: []models.ProjectTask{e, f, a, c}, wantPath: []string{"e", "f"}, wantTotal: 5},
+		{name: "unknown blocker", tasks: []models.ProjectTask{g}, wantPath: []string{"g"}, wantTotal: 1},
+		{name: "unscheduled task adds no time", tasks: []models.ProjectTask{a, graphTask("h", 0, a.ID)}, wantPath: []string{"a"}, wantTotal: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := criticalPath(project, tt.tasks)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := []string{}
+			for _, task := range result.Tasks {
+				names = append(names, task.Name)
+			}
+			if len(names) != len(tt.wantPath) {
+				t.Fatalf("got path %v, want %v", names, tt.wantPath)
+			}
+			for i := range names {
+				if names[i] != tt.wantPath[i] {
+					t.Fatalf("got path %v, want %v", names, tt.wantPath)
+				}
+			}
+
+			if result.TotalHours != tt.wantTotal {
+				t.Errorf("got total %v, want %v", result.TotalHours, tt.wantTotal)
+			}
+		})
+	}
+
+	// 경로의 각 Task는 앞 Task가 끝난 시점에 시작
+	result, _ := criticalPath(project, []models.ProjectTask{a, b, c, d})
+	for i, task := range result.Tasks {
+		if task.FinishHours != task.StartHours+task.DurationHours {
+			t.Errorf("task %s: finish %v != start %v + duration %v", task.Name, task.FinishHours, task.StartHours, task.DurationHours)
+		}
+		if i > 0 && task.StartHours != result.Tasks[i-1].FinishHours {
+			t.Errorf("task %s starts at %v, previous finishes at %v", task.Name, task.StartHours, result.Tasks[i-1].FinishHours)
+		}
+	}
+}
+
+func TestCriticalPathCycle(t *testing.T) {
+	a := graphTask("a", 1)
+	b := graphTask("b", 1, a.ID)
+	a.BlockedBy = []primitive.ObjectID{b.ID}
+
+	_, err := criticalPath(&models.Project{ID: primitive.NewObjectID()}, []models.ProjectTask{a, b, graphTask("c", 1)})
+	if statusOf(err) != http.StatusConflict {
+		t.Errorf("got %v, want conflict", err)
+	}
+}
@@ -18,14 +18,16 @@ import (
 )
 
 type ProjectTaskServiceImpl struct {
-	collection *mongo.Collection
+	collection        *mongo.Collection
+	projectCollection *mongo.Collection
+	auditCollection   *mongo.Collection
 }
 
-func NewProjectTaskServiceImpl(collection *mongo.Collection) services.ProjectTaskService {
-	return &ProjectTaskServiceImpl{collection}
+func NewProjectTaskServiceImpl(collection *mongo.Collection, projectCollection *mongo.Collection, auditCollection *mongo.Collection) services.ProjectTaskService {
+	return &ProjectTaskServiceImpl{collection, projectCollection, auditCollection}
 }
 
-func (pts *ProjectTaskServiceImpl) GetProjectTask(id string) (*models.ProjectTask, error) {
+func (pts *ProjectTaskServiceImpl) GetProjectTask(id string, userId string) (*models.ProjectTask, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -34,9 +36,18 @@ func (pts *ProjectTaskServiceImpl) GetProjectTask(id string) (*models.ProjectTas
 		return nil, utils.ConvertError("ProjectTask", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if _, _, err := pts.authorizeTask(ctx, taskId, actorId, projectRead); err != nil {
+		return nil, err
+	}
+
 	var task *models.ProjectTask
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: taskId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: taskId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -60,6 +71,7 @@ func (pts *ProjectTaskServiceImpl) GetProjectTask(id string) (*models.ProjectTas
 	}}}
 
 	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetProjectTask)...)
 
 	result, err := pts.collection.Aggregate(ctx, pipeline)
 
@@ -86,7 +98,7 @@ func (pts *ProjectTaskServiceImpl) GetProjectTask(id string) (*models.ProjectTas
 	return task, nil
 }
 
-func (pts *ProjectTaskServiceImpl) GetProjectTaskByProject(id string) ([]models.ProjectTask, error) {
+func (pts *ProjectTaskServiceImpl) GetProjectTaskByProject(id string, userId string) ([]models.ProjectTask, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -95,9 +107,18 @@ func (pts *ProjectTaskServiceImpl) GetProjectTaskByProject(id string) ([]models.
 		return nil, utils.ConvertError("Project", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if _, err := checkProjectAccess(ctx, pts.projectCollection, projectId, actorId, projectRead); err != nil {
+		return nil, err
+	}
+
 	var tasks []models.ProjectTask
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "project", Value: projectId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "project", Value: projectId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -121,6 +142,7 @@ func (pts *ProjectTaskServiceImpl) GetProjectTaskByProject(id string) ([]models.
 	}}}
 
 	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetProjectTask)...)
 
 	results, err := pts.collection.Aggregate(ctx, pipeline)
 
@@ -145,8 +167,8 @@ func (pts *ProjectTaskServiceImpl) GetProjectTaskByProject(id string) ([]models.
 	return tasks, nil
 }
 
-func (pts *ProjectTaskServiceImpl) CreateProjectTask(dto *dto.ProjectTaskCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (pts *ProjectTaskServiceImpl) CreateProjectTask(ctx context.Context, dto *dto.ProjectTaskCreateDTO, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
@@ -155,6 +177,9 @@ func (pts *ProjectTaskServiceImpl) CreateProjectTask(dto *dto.ProjectTaskCreateD
 		ID:              primitive.NewObjectID(),
 		TaskDescription: dto.TaskDescription,
 		Status:          dto.Status,
+		StartDt:         dto.StartDt,
+		EndDt:           dto.EndDt,
+		EstimateMinutes: dto.EstimateMinutes,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
@@ -165,6 +190,41 @@ func (pts *ProjectTaskServiceImpl) CreateProjectTask(dto *dto.ProjectTaskCreateD
 		return utils.ConvertError("Project", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, pts.projectCollection, task.Project, actorId, projectWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTaskSchedule(project, task.StartDt, task.EndDt); err != nil {
+		return err
+	}
+
+	if len(dto.BlockedBy) > 0 {
+		if task.BlockedBy, err = convertBlockedBy(dto.BlockedBy); err != nil {
+			return err
+		}
+
+		tasks, err := projectTasks(ctx, pts.collection, task.Project)
+		if err != nil {
+			return err
+		}
+
+		if err := validateBlockedBy(tasks, task.ID, task.BlockedBy); err != nil {
+			return err
+		}
+
+		if task.Status == models.TaskStatusDone {
+			if err := validateBlockersDone(tasks, task.BlockedBy); err != nil {
+				return err
+			}
+		}
+	}
+
 	if task.User, err = utils.ConvertToObjectId(dto.Manager); err != nil {
 		return utils.ConvertError("User", err)
 	}
@@ -185,11 +245,15 @@ func (pts *ProjectTaskServiceImpl) CreateProjectTask(dto *dto.ProjectTaskCreateD
 		}
 	}
 
+	if err := recordChange(ctx, pts.auditCollection, pts.collection, models.AuditResourceProjectTask, task.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (pts *ProjectTaskServiceImpl) UpdateProjectTask(id string, dto *dto.ProjectTaskUpdateDTO) (*models.ProjectTask, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (pts *ProjectTaskServiceImpl) UpdateProjectTask(ctx context.Context, id string, dto *dto.ProjectTaskUpdateDTO, userId string) (*models.ProjectTask, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	taskId, err := utils.ConvertToObjectId(id)
@@ -197,6 +261,16 @@ func (pts *ProjectTaskServiceImpl) UpdateProjectTask(id string, dto *dto.Project
 		return nil, utils.ConvertError("ProjectTask", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	current, project, err := pts.authorizeTask(ctx, taskId, actorId, projectWrite)
+	if err != nil {
+		return nil, err
+	}
+
 	task := bson.M{
 		"updated_at": time.Now(),
 	}
@@ -205,6 +279,10 @@ func (pts *ProjectTaskServiceImpl) UpdateProjectTask(id string, dto *dto.Project
 		task["task_description"] = dto.TaskDescription
 	}
 
+	if dto.EstimateMinutes > 0 {
+		task["estimate_minutes"] = dto.EstimateMinutes
+	}
+
 	if dto.Status != "" {
 		task["status"] = dto.Status
 	}
@@ -221,19 +299,68 @@ func (pts *ProjectTaskServiceImpl) UpdateProjectTask(id string, dto *dto.Project
 		}
 	}
 
-	filter := bson.M{"_id": taskId}
-	update := bson.M{"$set": task}
+	// 선행 Task를 변경하거나 완료 처리할 때 선행 관계 검증
+	blockedBy := current.BlockedBy
+	if dto.BlockedBy != nil {
+		if blockedBy, err = convertBlockedBy(dto.BlockedBy); err != nil {
+			return nil, err
+		}
+		task["blocked_by"] = blockedBy
+	}
+
+	if dto.BlockedBy != nil || (dto.Status == models.TaskStatusDone && len(blockedBy) > 0) {
+		tasks, err := projectTasks(ctx, pts.collection, current.Project)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateBlockedBy(tasks, taskId, blockedBy); err != nil {
+			return nil, err
+		}
+
+		status := current.Status
+		if dto.Status != "" {
+			status = dto.Status
+		}
+
+		if status == models.TaskStatusDone {
+			if err := validateBlockersDone(tasks, blockedBy); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 한쪽 일시만 변경해도 기존 일시와 함께 검증
+	if dto.StartDt != nil || dto.EndDt != nil {
+		startDt, endDt := current.StartDt, current.EndDt
+		if dto.StartDt != nil {
+			startDt = dto.StartDt
+			task["start_dt"] = dto.StartDt
+		}
+		if dto.EndDt != nil {
+			endDt = dto.EndDt
+			task["end_dt"] = dto.EndDt
+		}
+		if err := validateTaskSchedule(project, startDt, endDt); err != nil {
+			return nil, err
+		}
+	}
+
+	before := auditSnapshot(ctx, pts.collection, taskId)
+
+	filter := versionFilter(ctx, bson.M{"_id": taskId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": task})
 
 	fmt.Printf("task: %+v", task)
 
 	result := pts.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, pts.collection, taskId, &errors.CustomError{
 				Message:    "Project Task를 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -251,11 +378,15 @@ func (pts *ProjectTaskServiceImpl) UpdateProjectTask(id string, dto *dto.Project
 		}
 	}
 
+	if err := recordChange(ctx, pts.auditCollection, pts.collection, models.AuditResourceProjectTask, taskId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedTask, nil
 }
 
-func (pts *ProjectTaskServiceImpl) DeleteProjectTask(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (pts *ProjectTaskServiceImpl) DeleteProjectTask(ctx context.Context, id string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	taskId, err := utils.ConvertToObjectId(id)
@@ -263,9 +394,21 @@ func (pts *ProjectTaskServiceImpl) DeleteProjectTask(id string) error {
 		return utils.ConvertError("ProjectTask", err)
 	}
 
-	filter := bson.M{"_id": taskId}
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
 
-	result, err := pts.collection.DeleteOne(ctx, filter)
+	task, _, err := pts.authorizeTask(ctx, taskId, actorId, projectWrite)
+	if err != nil {
+		return err
+	}
+
+	before := auditSnapshot(ctx, pts.collection, taskId)
+
+	filter := versionFilter(ctx, bson.M{"_id": taskId})
+
+	result, err := softDelete(ctx, pts.collection, filter, nil)
 	if err != nil {
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -274,11 +417,88 @@ func (pts *ProjectTaskServiceImpl) DeleteProjectTask(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
-		return &errors.CustomError{
+	if result.MatchedCount == 0 {
+		return matchError(ctx, pts.collection, taskId, &errors.CustomError{
 			Message:    "Project Task를 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	if err := recordAudit(ctx, pts.auditCollection, models.AuditResourceProjectTask, models.AuditActionDelete, taskId, before, auditSnapshot(ctx, pts.collection, taskId)); err != nil {
+		return err
+	}
+
+	// 삭제된 Task를 마일스톤에서 제거
+	_, err = pts.projectCollection.UpdateOne(ctx, bson.M{"_id": task.Project}, bumpVersion(bson.M{"$pull": bson.M{"milestones.$[].tasks": taskId}}))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	// 삭제된 Task를 다른 Task의 선행 Task에서 제거
+	_, err = pts.collection.UpdateMany(ctx, bson.M{"blocked_by": taskId}, bumpVersion(bson.M{"$pull": bson.M{"blocked_by": taskId}}))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
+
+// authorizeTask Project Task를 조회하여 유저가 해당 Project에 요청한 수준의 권한을 가지는지 확인
+func (pts *ProjectTaskServiceImpl) authorizeTask(ctx context.Context, taskId primitive.ObjectID, userId primitive.ObjectID, access projectAccess) (*models.ProjectTask, *models.Project, error) {
+	var task models.ProjectTask
+
+	if err := pts.collection.FindOne(ctx, bson.M{"_id": taskId, "deleted_at": nil}).Decode(&task); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil, &errors.CustomError{
+				Message:    "Project Task를 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	project, err := checkProjectAccess(ctx, pts.projectCollection, task.Project, userId, access)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &task, project, nil
+}
+
+// validateTaskSchedule Task 일정이 시작 <= 종료이고 Project 기간 안에 있는지 확인
+func validateTaskSchedule(project *models.Project, startDt *time.Time, endDt *time.Time) error {
+	if startDt != nil && endDt != nil && endDt.Before(*startDt) {
+		return &errors.CustomError{
+			Message:    "Task 종료 일시가 시작 일시보다 빠름",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("end_dt %s is before start_dt %s", endDt, startDt),
+		}
+	}
+
+	for _, dt := range []*time.Time{startDt, endDt} {
+		if dt == nil {
+			continue
+		}
+		if (!project.StartDt.IsZero() && dt.Before(project.StartDt)) || (!project.EndDt.IsZero() && dt.After(project.EndDt)) {
+			return &errors.CustomError{
+				Message:    "Task 일정이 Project 기간을 벗어남",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("%s is outside of project period %s ~ %s", dt, project.StartDt, project.EndDt),
+			}
 		}
 	}
 
@@ -0,0 +1,435 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ProjectTemplateServiceImpl struct {
+	collection        *mongo.Collection
+	projectCollection *mongo.Collection
+	taskCollection    *mongo.Collection
+	auditCollection   *mongo.Collection
+}
+
+func NewProjectTemplateServiceImpl(collection *mongo.Collection, projectCollection *mongo.Collection, taskCollection *mongo.Collection, auditCollection *mongo.Collection) services.ProjectTemplateService {
+	return &ProjectTemplateServiceImpl{collection, projectCollection, taskCollection, auditCollection}
+}
+
+func (pts *ProjectTemplateServiceImpl) GetAllProjectTemplate() ([]models.ProjectTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var templates []models.ProjectTemplate
+
+	results, err := pts.collection.Find(ctx, bson.M{})
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &templates); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return templates, nil
+}
+
+func (pts *ProjectTemplateServiceImpl) GetProjectTemplate(id string) (*models.ProjectTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("ProjectTemplate", err)
+	}
+
+	return pts.findTemplate(ctx, templateId)
+}
+
+func (pts *ProjectTemplateServiceImpl) CreateProjectTemplate(dto *dto.ProjectTemplateCreateDTO, userId string) (*models.ProjectTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(dto.Project)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	project, err := checkProjectAccess(ctx, pts.projectCollection, projectId, actorId, projectRead)
+	if err != nil {
+		return nil, err
+	}
+
+	// 일정은 Project 시작일 기준 오프셋으로 저장하므로 시작일이 필요
+	if project.StartDt.IsZero() {
+		return nil, &errors.CustomError{
+			Message:    "시작일이 없는 Project는 템플릿으로 저장할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("project %s has no start_dt", projectId.Hex()),
+		}
+	}
+
+	tasks, err := projectTasks(ctx, pts.taskCollection, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	template := buildProjectTemplate(project, tasks)
+	template.ID = primitive.NewObjectID()
+	template.Name = dto.Name
+	template.CreatedBy = actorId
+	template.CreatedAt = time.Now()
+	template.UpdatedAt = time.Now()
+
+	if template.Name == "" {
+		template.Name = project.Name
+	}
+
+	if _, err := pts.collection.InsertOne(ctx, template); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return template, nil
+}
+
+func (pts *ProjectTemplateServiceImpl) InstantiateProjectTemplate(ctx context.Context, id string, dto *dto.ProjectTemplateInstantiateDTO, userId string) (*models.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("ProjectTemplate", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if dto.StartDt.IsZero() {
+		return nil, &errors.CustomError{
+			Message:    "Project 시작일이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("start_dt is required"),
+		}
+	}
+
+	managers := map[primitive.ObjectID]primitive.ObjectID{}
+	for from, to := range dto.Managers {
+		fromId, err := utils.ConvertToObjectId(from)
+		if err != nil {
+			return nil, utils.ConvertError("User", err)
+		}
+		toId, err := utils.ConvertToObjectId(to)
+		if err != nil {
+			return nil, utils.ConvertError("User", err)
+		}
+		managers[fromId] = toId
+	}
+
+	template, err := pts.findTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := dto.Name
+	if name == "" {
+		name = template.Name
+	}
+
+	project, tasks := instantiateProjectTemplate(template, name, dto.StartDt, managers, actorId)
+
+	session, err := pts.projectCollection.Database().Client().StartSession()
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if _, err := pts.projectCollection.InsertOne(sessCtx, project); err != nil {
+			return nil, err
+		}
+
+		if len(tasks) > 0 {
+			if _, err := pts.taskCollection.InsertMany(sessCtx, tasks); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := recordChange(ctx, pts.auditCollection, pts.projectCollection, models.AuditResourceProject, project.ID, nil); err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if err := recordChange(ctx, pts.auditCollection, pts.taskCollection, models.AuditResourceProjectTask, task.(models.ProjectTask).ID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return project, nil
+}
+
+func (pts *ProjectTemplateServiceImpl) DeleteProjectTemplate(id string, userId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("ProjectTemplate", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	template, err := pts.findTemplate(ctx, templateId)
+	if err != nil {
+		return err
+	}
+
+	if template.CreatedBy != actorId {
+		return &errors.CustomError{
+			Message:    "템플릿 작성자만 삭제할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s is not the creator of template %s", userId, id),
+		}
+	}
+
+	result, err := pts.collection.DeleteOne(ctx, bson.M{"_id": templateId})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return &errors.CustomError{
+			Message:    "Project 템플릿을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	return nil
+}
+
+// findTemplate 템플릿을 조회
+func (pts *ProjectTemplateServiceImpl) findTemplate(ctx context.Context, templateId primitive.ObjectID) (*models.ProjectTemplate, error) {
+	var template models.ProjectTemplate
+
+	if err := pts.collection.FindOne(ctx, bson.M{"_id": templateId}).Decode(&template); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "Project 템플릿을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return &template, nil
+}
+
+// buildProjectTemplate Project와 Task의 일정을 Project 시작일 기준 오프셋으로 변환하고
+// Task 간 참조(선행 Task, 마일스톤)는 tasks 배열의 인덱스로 바꿈
+func buildProjectTemplate(project *models.Project, tasks []models.ProjectTask) *models.ProjectTemplate {
+	base := project.StartDt
+
+	template := &models.ProjectTemplate{
+		Source:     project.ID,
+		Tasks:      []models.TemplateTask{},
+		Milestones: []models.TemplateMilestone{},
+	}
+
+	if !project.EndDt.IsZero() {
+		template.EndOffset = offsetMinutes(base, &project.EndDt)
+	}
+
+	indexes := map[primitive.ObjectID]int{}
+	for i, task := range tasks {
+		indexes[task.ID] = i
+	}
+
+	for _, task := range tasks {
+		templateTask := models.TemplateTask{
+			Manager:         task.User,
+			Department:      task.Department,
+			TaskDescription: task.TaskDescription,
+			StartOffset:     offsetMinutes(base, task.StartDt),
+			EndOffset:       offsetMinutes(base, task.EndDt),
+			EstimateMinutes: task.EstimateMinutes,
+		}
+		for _, blockerId := range task.BlockedBy {
+			if i, ok := indexes[blockerId]; ok {
+				templateTask.BlockedBy = append(templateTask.BlockedBy, i)
+			}
+		}
+		template.Tasks = append(template.Tasks, templateTask)
+	}
+
+	for _, milestone := range project.Milestones {
+		templateMilestone := models.TemplateMilestone{
+			Name:      milestone.Name,
+			DueOffset: *offsetMinutes(base, &milestone.DueDt),
+			Tasks:     []int{},
+		}
+		for _, taskId := range milestone.Tasks {
+			if i, ok := indexes[taskId]; ok {
+				templateMilestone.Tasks = append(templateMilestone.Tasks, i)
+			}
+		}
+		template.Milestones = append(template.Milestones, templateMilestone)
+	}
+
+	return template
+}
+
+// instantiateProjectTemplate 템플릿 오프셋을 startDt 기준 일시로 되돌려 새 Project와 Task를 생성
+// managers에 있는 담당자는 새 담당자로 바꾸고, 담당자는 모두 maintainer로 Project 멤버에 추가
+func instantiateProjectTemplate(template *models.ProjectTemplate, name string, startDt time.Time, managers map[primitive.ObjectID]primitive.ObjectID, ownerId primitive.ObjectID) (*models.Project, []interface{}) {
+	now := time.Now()
+
+	project := &models.Project{
+		ID:         primitive.NewObjectID(),
+		Name:       name,
+		Owner:      ownerId,
+		Members:    []models.ProjectMember{{User: ownerId, Role: models.ProjectRoleOwner, AddedAt: now}},
+		Milestones: []models.Milestone{},
+		StartDt:    startDt,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if endDt := applyOffset(startDt, template.EndOffset); endDt != nil {
+		project.EndDt = *endDt
+	}
+
+	taskIds := make([]primitive.ObjectID, len(template.Tasks))
+	for i := range template.Tasks {
+		taskIds[i] = primitive.NewObjectID()
+	}
+
+	members := map[primitive.ObjectID]bool{ownerId: true}
+	tasks := make([]interface{}, 0, len(template.Tasks))
+
+	for i, templateTask := range template.Tasks {
+		manager := templateTask.Manager
+		if to, ok := managers[manager]; ok {
+			manager = to
+		}
+
+		task := models.ProjectTask{
+			ID:              taskIds[i],
+			Project:         project.ID,
+			User:            manager,
+			Department:      templateTask.Department,
+			TaskDescription: templateTask.TaskDescription,
+			Status:          models.TaskStatusTodo,
+			StartDt:         applyOffset(startDt, templateTask.StartOffset),
+			EndDt:           applyOffset(startDt, templateTask.EndOffset),
+			EstimateMinutes: templateTask.EstimateMinutes,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		for _, j := range templateTask.BlockedBy {
+			if j >= 0 && j < len(taskIds) {
+				task.BlockedBy = append(task.BlockedBy, taskIds[j])
+			}
+		}
+		tasks = append(tasks, task)
+
+		if !manager.IsZero() && !members[manager] {
+			members[manager] = true
+			project.Members = append(project.Members, models.ProjectMember{User: manager, Role: models.ProjectRoleMaintainer, AddedAt: now})
+		}
+	}
+
+	for _, templateMilestone := range template.Milestones {
+		milestone := models.Milestone{
+			ID:    primitive.NewObjectID(),
+			Name:  templateMilestone.Name,
+			DueDt: startDt.Add(time.Duration(templateMilestone.DueOffset) * time.Minute),
+			Tasks: []primitive.ObjectID{},
+		}
+		for _, j := range templateMilestone.Tasks {
+			if j >= 0 && j < len(taskIds) {
+				milestone.Tasks = append(milestone.Tasks, taskIds[j])
+			}
+		}
+		project.Milestones = append(project.Milestones, milestone)
+	}
+
+	return project, tasks
+}
+
+// offsetMinutes base부터 t까지의 분, t가 없으면 nil
+func offsetMinutes(base time.Time, t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	minutes := int64(t.Sub(base) / time.Minute)
+	return &minutes
+}
+
+// applyOffset base에 분 단위 오프셋을 더한 일시, 오프셋이 없으면 nil
+func applyOffset(base time.Time, minutes *int64) *time.Time {
+	if minutes == nil {
+		return nil
+	}
+	t := base.Add(time.Duration(*minutes) * time.Minute)
+	return &t
+}
@@ -0,0 +1,190 @@
+package impl
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type funnelResult struct {
+	Total        overdueCount             `bson:"total"`
+	ByStage      []statusCount            `bson:"by_stage"`
+	Reached      []statusCount            `bson:"reached"`
+	HireHours    []hireHours              `bson:"hire_hours"`
+	ByDepartment []models.FunnelBreakdown `bson:"by_department"`
+	ByPosition   []models.FunnelBreakdown `bson:"by_position"`
+	ByLocation   []models.FunnelBreakdown `bson:"by_location"`
+}
+
+type hireHours struct {
+	Hours float64 `bson:"hours"`
+}
+
+// hiredExpr 채용 결정이 hire인지 확인하는 aggregation 식
+var hiredExpr = bson.D{{Key: "$eq", Value: bson.A{"$decision.decision", models.HiringDecisionHire}}}
+
+// breakdownFacet field 기준으로 지원 수와 채용 수를 세는 $facet 하위 파이프라인
+func breakdownFacet(field string) bson.A {
+	return bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + field},
+			{Key: "total", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "hired", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{hiredExpr, 1, 0}}}}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "total", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+}
+
+// recruitingFunnel JobApplication을 $facet으로 집계하여 단계별 인원, 전환율, 채용 소요 시간 중앙값, 부서/포지션/근무지별 현황을 계산
+// stageOrder가 없으면 도달 인원이 많은 단계부터 순서로 봄
+func recruitingFunnel(ctx context.Context, collection *mongo.Collection, match bson.D, stageOrder []string) (*models.RecruitingFunnel, error) {
+	matchStage := bson.D{{Key: "$match", Value: match}}
+
+	facetStage := bson.D{{Key: "$facet", Value: bson.D{
+		{Key: "total", Value: bson.A{bson.D{{Key: "$count", Value: "count"}}}},
+		{Key: "by_stage", Value: bson.A{
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$stage"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}}},
+		}},
+		// 현재 단계와 이동 기록에 있는 단계를 모두 도달한 단계로 봄
+		{Key: "reached", Value: bson.A{
+			bson.D{{Key: "$project", Value: bson.D{
+				{Key: "stages", Value: bson.D{{Key: "$setUnion", Value: bson.A{
+					bson.D{{Key: "$ifNull", Value: bson.A{"$stage_history.stage", bson.A{}}}},
+					bson.A{"$stage"},
+				}}}},
+			}}},
+			bson.D{{Key: "$unwind", Value: "$stages"}},
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$stages"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}}},
+		}},
+		{Key: "hire_hours", Value: bson.A{
+			bson.D{{Key: "$match", Value: bson.D{{Key: "decision.decision", Value: models.HiringDecisionHire}}}},
+			bson.D{{Key: "$project", Value: bson.D{
+				{Key: "hours", Value: bson.D{{Key: "$divide", Value: bson.A{
+					bson.D{{Key: "$subtract", Value: bson.A{"$decision.decided_at", "$created_at"}}},
+					3600000,
+				}}}},
+			}}},
+		}},
+		{Key: "by_department", Value: append(breakdownFacet("department"),
+			bson.D{{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "departments"},
+				{Key: "localField", Value: "_id"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "department_info"},
+			}}},
+			bson.D{{Key: "$addFields", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "$toString", Value: "$_id"}}},
+				{Key: "name", Value: bson.D{{Key: "$first", Value: "$department_info.name"}}},
+			}}},
+		)},
+		{Key: "by_position", Value: breakdownFacet("position")},
+		{Key: "by_location", Value: breakdownFacet("location")},
+	}}}
+
+	var result funnelResult
+	if err := aggregateOne(ctx, collection, mongo.Pipeline{matchStage, facetStage}, &result); err != nil {
+		return nil, err
+	}
+
+	funnel := &models.RecruitingFunnel{
+		Total:        result.Total.total(),
+		Stages:       funnelStages(result.ByStage, result.Reached, stageOrder),
+		ByDepartment: result.ByDepartment,
+		ByPosition:   result.ByPosition,
+		ByLocation:   result.ByLocation,
+	}
+
+	hours := make([]float64, 0, len(result.HireHours))
+	for _, hire := range result.HireHours {
+		hours = append(hours, hire.Hours)
+	}
+	funnel.Hired = len(hours)
+	funnel.MedianTimeToHireHours = median(hours)
+
+	if funnel.ByDepartment == nil {
+		funnel.ByDepartment = []models.FunnelBreakdown{}
+	}
+	if funnel.ByPosition == nil {
+		funnel.ByPosition = []models.FunnelBreakdown{}
+	}
+	if funnel.ByLocation == nil {
+		funnel.ByLocation = []models.FunnelBreakdown{}
+	}
+
+	return funnel, nil
+}
+
+// funnelStages 단계 순서대로 현재/도달 인원을 정리하고 이전 단계 대비 전환율을 계산
+func funnelStages(byStage []statusCount, reached []statusCount, stageOrder []string) []models.FunnelStage {
+	current := map[string]int{}
+	for _, stage := range byStage {
+		current[stage.Status] = stage.Count
+	}
+
+	reachedCount := map[string]int{}
+	for _, stage := range reached {
+		reachedCount[stage.Status] = stage.Count
+	}
+
+	order := stageOrder
+	if len(order) == 0 {
+		for stage := range reachedCount {
+			order = append(order, stage)
+		}
+		sort.Slice(order, func(i, j int) bool {
+			if reachedCount[order[i]] != reachedCount[order[j]] {
+				return reachedCount[order[i]] > reachedCount[order[j]]
+			}
+			return order[i] < order[j]
+		})
+	}
+
+	stages := make([]models.FunnelStage, 0, len(order))
+	for i, key := range order {
+		stage := models.FunnelStage{Stage: key, Current: current[key], Reached: reachedCount[key]}
+		if i > 0 && stages[i-1].Reached > 0 {
+			rate := math.Round(float64(stage.Reached)/float64(stages[i-1].Reached)*1000) / 10
+			stage.ConversionRate = &rate
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages
+}
+
+// median 중앙값(소수 첫째 자리), 값이 없으면 nil
+func median(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sort.Float64s(values)
+
+	mid := len(values) / 2
+	result := values[mid]
+	if len(values)%2 == 0 {
+		result = (values[mid-1] + values[mid]) / 2
+	}
+
+	result = math.Round(result*10) / 10
+	return &result
+}
+
+// funnelPeriod 조회 기간을 응답용 포인터로 변환
+func funnelPeriod(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
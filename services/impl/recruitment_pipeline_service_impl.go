@@ -0,0 +1,413 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RecruitmentPipelineServiceImpl struct {
+	collection               *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+}
+
+func NewRecruitmentPipelineServiceImpl(collection *mongo.Collection, jobApplicationCollection *mongo.Collection) services.RecruitmentPipelineService {
+	return &RecruitmentPipelineServiceImpl{collection, jobApplicationCollection}
+}
+
+func (rps *RecruitmentPipelineServiceImpl) GetAllRecruitmentPipeline() ([]models.RecruitmentPipeline, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var pipelines []models.RecruitmentPipeline
+
+	results, err := rps.collection.Find(ctx, bson.M{})
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &pipelines); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return pipelines, nil
+}
+
+func (rps *RecruitmentPipelineServiceImpl) GetRecruitmentPipeline(id string) (*models.RecruitmentPipeline, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pipelineId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("RecruitmentPipeline", err)
+	}
+
+	return rps.findPipeline(ctx, pipelineId)
+}
+
+func (rps *RecruitmentPipelineServiceImpl) GetRecruitmentPipelineMetrics(id string) ([]models.StageMetric, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pipelineId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("RecruitmentPipeline", err)
+	}
+
+	pipeline, err := rps.findPipeline(ctx, pipelineId)
+	if err != nil {
+		return nil, err
+	}
+
+	var applications []models.JobApplication
+
+	results, err := rps.jobApplicationCollection.Find(ctx, bson.M{"department": pipeline.Department, "deleted_at": nil})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &applications); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return stageMetrics(pipeline, applications, time.Now()), nil
+}
+
+func (rps *RecruitmentPipelineServiceImpl) CreateRecruitmentPipeline(dto *dto.RecruitmentPipelineCreateDTO) (*models.RecruitmentPipeline, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	departmentId, err := utils.ConvertToObjectId(dto.Department)
+	if err != nil {
+		return nil, utils.ConvertError("Department", err)
+	}
+
+	stages, err := convertStages(dto.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &models.RecruitmentPipeline{
+		ID:         primitive.NewObjectID(),
+		Department: departmentId,
+		Name:       dto.Name,
+		Stages:     stages,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+
+	// 부서당 파이프라인은 department 유니크 인덱스로 하나만 허용
+	if _, err := rps.collection.InsertOne(ctx, pipeline); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &errors.CustomError{
+				Message:    "부서에 이미 채용 파이프라인이 있음",
+				StatusCode: http.StatusConflict,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return pipeline, nil
+}
+
+func (rps *RecruitmentPipelineServiceImpl) UpdateRecruitmentPipeline(ctx context.Context, id string, dto *dto.RecruitmentPipelineUpdateDTO) (*models.RecruitmentPipeline, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	pipelineId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("RecruitmentPipeline", err)
+	}
+
+	pipeline := bson.M{
+		"updated_at": time.Now(),
+	}
+
+	if dto.Name != "" {
+		pipeline["name"] = dto.Name
+	}
+
+	if dto.Stages != nil {
+		stages, err := convertStages(dto.Stages)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := rps.checkRemovedStages(ctx, pipelineId, stages); err != nil {
+			return nil, err
+		}
+
+		pipeline["stages"] = stages
+	}
+
+	filter := versionFilter(ctx, bson.M{"_id": pipelineId})
+	update := bumpVersion(bson.M{"$set": pipeline})
+
+	result := rps.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, rps.collection, pipelineId, &errors.CustomError{
+				Message:    "채용 파이프라인을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        result.Err(),
+			})
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        result.Err(),
+		}
+	}
+
+	var updatedPipeline *models.RecruitmentPipeline
+	if err := result.Decode(&updatedPipeline); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "결과 디코딩 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return updatedPipeline, nil
+}
+
+func (rps *RecruitmentPipelineServiceImpl) DeleteRecruitmentPipeline(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	pipelineId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("RecruitmentPipeline", err)
+	}
+
+	result, err := rps.collection.DeleteOne(ctx, versionFilter(ctx, bson.M{"_id": pipelineId}))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return matchError(ctx, rps.collection, pipelineId, &errors.CustomError{
+			Message:    "채용 파이프라인을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	return nil
+}
+
+// findPipeline 채용 파이프라인을 조회
+func (rps *RecruitmentPipelineServiceImpl) findPipeline(ctx context.Context, pipelineId primitive.ObjectID) (*models.RecruitmentPipeline, error) {
+	var pipeline models.RecruitmentPipeline
+
+	if err := rps.collection.FindOne(ctx, bson.M{"_id": pipelineId}).Decode(&pipeline); err != nil {
+		return nil, findError("채용 파이프라인을 찾을 수 없음", err)
+	}
+
+	return &pipeline, nil
+}
+
+// checkRemovedStages 단계 교체로 빠지는 단계에 부서 지원서가 남아 있으면 거부
+// 남은 지원서는 이동할 단계가 없어지므로 먼저 다른 단계로 옮겨야 함, 휴지통의 지원서도 복원될 수 있으므로 포함
+func (rps *RecruitmentPipelineServiceImpl) checkRemovedStages(ctx context.Context, pipelineId primitive.ObjectID, stages []models.PipelineStage) error {
+	current, err := rps.findPipeline(ctx, pipelineId)
+	if err != nil {
+		return err
+	}
+
+	kept := map[string]bool{}
+	for _, stage := range stages {
+		kept[stage.Key] = true
+	}
+
+	removed := []string{}
+	for _, stage := range current.Stages {
+		if !kept[stage.Key] {
+			removed = append(removed, stage.Key)
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	inUse, err := rps.jobApplicationCollection.Distinct(ctx, "stage", bson.M{"department": current.Department, "stage": bson.M{"$in": removed}})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if len(inUse) > 0 {
+		return &errors.CustomError{
+			Message:    "지원서가 남아 있는 단계는 삭제할 수 없음",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("job applications remain in stages %v", inUse),
+		}
+	}
+
+	return nil
+}
+
+// departmentPipeline 부서의 채용 파이프라인을 조회, 정의되지 않았으면 nil
+func departmentPipeline(ctx context.Context, collection *mongo.Collection, departmentId primitive.ObjectID) (*models.RecruitmentPipeline, error) {
+	if departmentId.IsZero() {
+		return nil, nil
+	}
+
+	var pipeline models.RecruitmentPipeline
+
+	if err := collection.FindOne(ctx, bson.M{"department": departmentId}).Decode(&pipeline); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return &pipeline, nil
+}
+
+// convertStages 단계 key가 비어 있거나 중복되지 않고 next가 정의된 단계만 가리키는지 확인
+func convertStages(stageDTOs []dto.PipelineStageDTO) ([]models.PipelineStage, error) {
+	if len(stageDTOs) == 0 {
+		return nil, &errors.CustomError{
+			Message:    "채용 단계가 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("stages are required"),
+		}
+	}
+
+	keys := map[string]bool{}
+	for _, stage := range stageDTOs {
+		if stage.Key == "" || keys[stage.Key] {
+			return nil, &errors.CustomError{
+				Message:    "채용 단계 key가 비어 있거나 중복됨",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("invalid stage key: %q", stage.Key),
+			}
+		}
+		keys[stage.Key] = true
+	}
+
+	stages := make([]models.PipelineStage, 0, len(stageDTOs))
+	for _, stage := range stageDTOs {
+		for _, next := range stage.Next {
+			if !keys[next] {
+				return nil, &errors.CustomError{
+					Message:    "정의되지 않은 다음 단계",
+					StatusCode: http.StatusBadRequest,
+					Err:        fmt.Errorf("stage %s has unknown next stage %s", stage.Key, next),
+				}
+			}
+		}
+
+		name := stage.Name
+		if name == "" {
+			name = stage.Key
+		}
+
+		stages = append(stages, models.PipelineStage{
+			Key:      stage.Key,
+			Name:     name,
+			Terminal: stage.Terminal,
+			Next:     stage.Next,
+		})
+	}
+
+	return stages, nil
+}
+
+// stageMetrics 단계별 현재 지원자 수, 진입 횟수, 평균 체류 시간을 계산
+// 평균 체류 시간은 다음 단계로 넘어간 기록만으로 계산
+func stageMetrics(pipeline *models.RecruitmentPipeline, applications []models.JobApplication, now time.Time) []models.StageMetric {
+	type stageTotal struct {
+		current, entered, completed int
+		hours                       float64
+	}
+
+	totals := map[string]*stageTotal{}
+	for _, stage := range pipeline.Stages {
+		totals[stage.Key] = &stageTotal{}
+	}
+
+	for _, application := range applications {
+		for _, duration := range application.StageDurations(now) {
+			total, ok := totals[duration.Stage]
+			if !ok {
+				continue
+			}
+			total.entered++
+			if duration.Current {
+				total.current++
+			} else {
+				total.completed++
+				total.hours += duration.Hours
+			}
+		}
+	}
+
+	metrics := make([]models.StageMetric, 0, len(pipeline.Stages))
+	for _, stage := range pipeline.Stages {
+		total := totals[stage.Key]
+		metric := models.StageMetric{
+			Stage:   stage.Key,
+			Name:    stage.Name,
+			Current: total.current,
+			Entered: total.entered,
+		}
+		if total.completed > 0 {
+			metric.AverageHours = math.Round(total.hours/float64(total.completed)*100) / 100
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics
+}
@@ -0,0 +1,435 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RetentionServiceImpl struct {
+	collection               *mongo.Collection
+	erasureLogCollection     *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+	candidateCollection      *mongo.Collection
+	meetingCollection        *mongo.Collection
+	auditCollection          *mongo.Collection
+}
+
+func NewRetentionServiceImpl(collection *mongo.Collection, erasureLogCollection *mongo.Collection, jobApplicationCollection *mongo.Collection, candidateCollection *mongo.Collection, meetingCollection *mongo.Collection, auditCollection *mongo.Collection) services.RetentionService {
+	return &RetentionServiceImpl{collection, erasureLogCollection, jobApplicationCollection, candidateCollection, meetingCollection, auditCollection}
+}
+
+func (rs *RetentionServiceImpl) GetAllRetentionPolicy() ([]models.RetentionPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return rs.findPolicies(ctx)
+}
+
+func (rs *RetentionServiceImpl) SetRetentionPolicy(outcome string, dto *dto.RetentionPolicyDTO) (*models.RetentionPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if !models.IsValidRetentionOutcome(outcome) {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 지원 결과",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid retention outcome: %s", outcome),
+		}
+	}
+
+	if dto.RetentionDays <= 0 {
+		return nil, &errors.CustomError{
+			Message:    "보존 기간은 1일 이상이어야 함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid retention days: %d", dto.RetentionDays),
+		}
+	}
+
+	now := time.Now()
+	filter := bson.M{"outcome": outcome}
+	update := bson.M{
+		"$set":         bson.M{"retention_days": dto.RetentionDays, "updated_at": now},
+		"$setOnInsert": bson.M{"_id": primitive.NewObjectID(), "created_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var policy models.RetentionPolicy
+	if err := rs.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&policy); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return &policy, nil
+}
+
+func (rs *RetentionServiceImpl) DeleteRetentionPolicy(outcome string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := rs.collection.DeleteOne(ctx, bson.M{"outcome": outcome})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return &errors.CustomError{
+			Message:    "보존 정책을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	return nil
+}
+
+func (rs *RetentionServiceImpl) GetErasureLogs(query *dto.ErasureLogQueryDTO) ([]models.ErasureLog, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if period := periodFilter(query.From, query.To); len(period) > 0 {
+		filter["erased_at"] = period
+	}
+	if query.Reason != "" {
+		filter["reason"] = query.Reason
+	}
+
+	var logs []models.ErasureLog
+
+	results, err := rs.erasureLogCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "erased_at", Value: -1}}))
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &logs); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return logs, nil
+}
+
+func (rs *RetentionServiceImpl) EraseCandidate(ctx context.Context, id string, userId string) (*models.RetentionRunResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	candidateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Candidate", err)
+	}
+
+	erasedBy, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	count, err := rs.candidateCollection.CountDocuments(ctx, bson.M{"_id": candidateId})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count == 0 {
+		return nil, &errors.CustomError{
+			Message:    "지원자를 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		}
+	}
+
+	erasure := models.ErasureLog{Reason: models.ErasureReasonManual, ErasedBy: &erasedBy}
+
+	anonymized, err := rs.anonymizeJobApplications(ctx, bson.M{"candidate": candidateId}, erasure)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.deleteCandidate(ctx, candidateId, erasure); err != nil {
+		return nil, err
+	}
+
+	return &models.RetentionRunResult{Anonymized: anonymized, Candidates: 1}, nil
+}
+
+func (rs *RetentionServiceImpl) ApplyRetentionPolicies(ctx context.Context) (*models.RetentionRunResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	policies, err := rs.findPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.RetentionRunResult{}
+	now := time.Now()
+
+	for _, policy := range policies {
+		policyId := policy.ID
+		erasure := models.ErasureLog{Reason: models.ErasureReasonRetention, Policy: &policyId}
+
+		filter := expiredFilter(policy, now)
+
+		// 익명화로 참조가 사라질 지원자를 먼저 구함
+		candidateIds, err := rs.jobApplicationCollection.Distinct(ctx, "candidate", filter)
+		if err != nil {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		anonymized, err := rs.anonymizeJobApplications(ctx, filter, erasure)
+		if err != nil {
+			return nil, err
+		}
+		result.Anonymized += anonymized
+
+		// 다른 지원 이력이 남아 있지 않은 지원자만 삭제
+		for _, value := range candidateIds {
+			candidateId, ok := value.(primitive.ObjectID)
+			if !ok {
+				continue
+			}
+
+			remaining, err := rs.jobApplicationCollection.CountDocuments(ctx, bson.M{"candidate": candidateId})
+			if err != nil {
+				return nil, &errors.CustomError{
+					Message:    "내부 서버 오류",
+					StatusCode: http.StatusInternalServerError,
+					Err:        err,
+				}
+			}
+
+			if remaining > 0 {
+				continue
+			}
+
+			if err := rs.deleteCandidate(ctx, candidateId, erasure); err != nil {
+				return nil, err
+			}
+			result.Candidates++
+		}
+	}
+
+	return result, nil
+}
+
+// findPolicies 전체 보존 정책을 조회
+func (rs *RetentionServiceImpl) findPolicies(ctx context.Context) ([]models.RetentionPolicy, error) {
+	var policies []models.RetentionPolicy
+
+	results, err := rs.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &policies); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return policies, nil
+}
+
+// expiredFilter 보존 기간이 지났고 아직 익명화되지 않은 JobApplication 조건
+// 채용 결정이 있으면 결정 일시, 없으면 마지막 수정 일시를 기준으로 봄
+func expiredFilter(policy models.RetentionPolicy, now time.Time) bson.M {
+	cutoff := now.AddDate(0, 0, -policy.RetentionDays)
+
+	filter := bson.M{"anonymized_at": bson.M{"$exists": false}}
+	if policy.Outcome == models.RetentionOutcomeUndecided {
+		filter["decision"] = bson.M{"$exists": false}
+		filter["updated_at"] = bson.M{"$lt": cutoff}
+	} else {
+		filter["decision.decision"] = policy.Outcome
+		filter["decision.decided_at"] = bson.M{"$lt": cutoff}
+	}
+
+	return filter
+}
+
+// anonymizeJobApplications 조건에 맞는 JobApplication의 개인정보와 첨부 파일을 지우고 건별로 삭제 기록을 남김
+func (rs *RetentionServiceImpl) anonymizeJobApplications(ctx context.Context, filter bson.M, erasure models.ErasureLog) (int, error) {
+	var jobApplications []models.JobApplication
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "candidate": 1, "attachments": 1, "position": 1, "task": 1, "stage": 1})
+
+	results, err := rs.jobApplicationCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &jobApplications); err != nil {
+		return 0, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if len(jobApplications) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	ids := make([]primitive.ObjectID, 0, len(jobApplications))
+	logs := make([]interface{}, 0, len(jobApplications))
+
+	for _, jobApplication := range jobApplications {
+		jobApplicationId := jobApplication.ID
+		ids = append(ids, jobApplicationId)
+
+		interviews, err := rs.anonymizeInterviews(ctx, &jobApplication, now)
+		if err != nil {
+			return 0, err
+		}
+
+		log := erasure
+		log.ID = primitive.NewObjectID()
+		log.JobApplication = &jobApplicationId
+		log.Fields = models.AnonymizedFields
+		log.Attachments = len(jobApplication.Attachments)
+		log.Interviews = interviews
+		log.ErasedAt = now
+		if !jobApplication.Candidate.IsZero() {
+			candidateId := jobApplication.Candidate
+			log.Candidate = &candidateId
+		}
+		logs = append(logs, log)
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"applicant_name": models.AnonymizedApplicantName, "anonymized_at": now, "updated_at": now},
+		"$unset": bson.M{"candidate": "", "attachments": ""},
+	}
+
+	batch := newAuditBatch(ctx, rs.jobApplicationCollection, models.AuditResourceJobApplication, bson.M{"_id": bson.M{"$in": ids}})
+
+	if _, err := rs.jobApplicationCollection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bumpVersion(update)); err != nil {
+		return 0, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if _, err := rs.erasureLogCollection.InsertMany(ctx, logs); err != nil {
+		return 0, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := batch.record(ctx, rs.auditCollection); err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// anonymizeInterviews 면접 Meeting의 제목과 설명을 지원자 이름 없이 다시 작성, 휴지통에 있는 Meeting도 포함
+// 면접관이 입력한 제목과 메모에도 이름이 있을 수 있으므로 기본 제목과 지원서 정보만 남김
+func (rs *RetentionServiceImpl) anonymizeInterviews(ctx context.Context, jobApplication *models.JobApplication, now time.Time) (int, error) {
+	filter := bson.M{"interview.job_application": jobApplication.ID}
+
+	anonymized := *jobApplication
+	anonymized.ApplicantName = models.AnonymizedApplicantName
+
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"title":       interviewTitle(&anonymized),
+		"description": interviewDescription(&anonymized, ""),
+		"updated_at":  now,
+	}})
+
+	batch := newAuditBatch(ctx, rs.meetingCollection, models.AuditResourceMeeting, filter)
+
+	result, err := rs.meetingCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := batch.record(ctx, rs.auditCollection); err != nil {
+		return 0, err
+	}
+
+	return int(result.MatchedCount), nil
+}
+
+// deleteCandidate 지원자 문서를 삭제하고 삭제 기록을 남김
+func (rs *RetentionServiceImpl) deleteCandidate(ctx context.Context, candidateId primitive.ObjectID, erasure models.ErasureLog) error {
+	if _, err := rs.candidateCollection.DeleteOne(ctx, bson.M{"_id": candidateId}); err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	log := erasure
+	log.ID = primitive.NewObjectID()
+	log.Candidate = &candidateId
+	log.Fields = []string{"name", "email", "phone", "links"}
+	log.ErasedAt = time.Now()
+
+	if _, err := rs.erasureLogCollection.InsertOne(ctx, log); err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,380 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ScorecardServiceImpl struct {
+	collection               *mongo.Collection
+	templateCollection       *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+	meetingCollection        *mongo.Collection
+	auditCollection          *mongo.Collection
+}
+
+func NewScorecardServiceImpl(collection *mongo.Collection, templateCollection *mongo.Collection, jobApplicationCollection *mongo.Collection, meetingCollection *mongo.Collection, auditCollection *mongo.Collection) services.ScorecardService {
+	return &ScorecardServiceImpl{collection, templateCollection, jobApplicationCollection, meetingCollection, auditCollection}
+}
+
+func (ss *ScorecardServiceImpl) GetScorecardByJobApplication(id string, userId string) ([]models.Scorecard, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobApplication, viewerId, err := ss.authorizeJobApplication(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ss.checkScorecardVisible(ctx, jobApplication, viewerId); err != nil {
+		return nil, err
+	}
+
+	return ss.findScorecards(ctx, jobApplication.ID)
+}
+
+func (ss *ScorecardServiceImpl) GetScorecardSummary(id string, userId string) (*models.ScorecardSummary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobApplication, viewerId, err := ss.authorizeJobApplication(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ss.checkScorecardVisible(ctx, jobApplication, viewerId); err != nil {
+		return nil, err
+	}
+
+	scorecards, err := ss.findScorecards(ctx, jobApplication.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeScorecards(scorecards)
+	summary.JobApplication = jobApplication.ID
+	summary.Decision = jobApplication.Decision
+
+	return summary, nil
+}
+
+func (ss *ScorecardServiceImpl) SubmitScorecard(id string, dto *dto.ScorecardSubmitDTO, userId string) (*models.Scorecard, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobApplication, interviewerId, err := ss.authorizeJobApplication(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 해당 지원서 면접 Meeting의 참석자만 평가표를 제출할 수 있음
+	count, err := ss.meetingCollection.CountDocuments(ctx, bson.M{
+		"interview.job_application": jobApplication.ID,
+		"participants.participant":  interviewerId,
+		"deleted_at":                nil,
+	})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count == 0 {
+		return nil, &errors.CustomError{
+			Message:    "면접관만 평가표를 제출할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s is not an interviewer of job application %s", interviewerId.Hex(), jobApplication.ID.Hex()),
+		}
+	}
+
+	if !models.IsValidRecommendation(dto.Recommendation) {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 추천 의견",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid recommendation: %s", dto.Recommendation),
+		}
+	}
+
+	var template models.ScorecardTemplate
+	if err := ss.templateCollection.FindOne(ctx, bson.M{"position": jobApplication.Position}).Decode(&template); err != nil {
+		return nil, findError("포지션의 평가표 템플릿을 찾을 수 없음", err)
+	}
+
+	ratings, score, err := scoreRatings(&template, dto.Ratings)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scorecard := &models.Scorecard{
+		ID:             primitive.NewObjectID(),
+		JobApplication: jobApplication.ID,
+		Interviewer:    interviewerId,
+		Template:       template.ID,
+		Ratings:        ratings,
+		Score:          score,
+		Recommendation: dto.Recommendation,
+		Notes:          dto.Notes,
+		SubmittedAt:    now,
+		UpdatedAt:      now,
+	}
+
+	// 제출한 평가표는 바꿀 수 없음, 다른 면접관의 평가를 본 뒤 고쳐 내는 것을 막기 위해
+	// (job_application, interviewer) 유니크 인덱스로 두 번째 제출을 거절
+	if _, err := ss.collection.InsertOne(ctx, scorecard); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &errors.CustomError{
+				Message:    "이미 평가표를 제출함",
+				StatusCode: http.StatusConflict,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return scorecard, nil
+}
+
+func (ss *ScorecardServiceImpl) DecideJobApplication(ctx context.Context, id string, dto *dto.HiringDecisionDTO, userId string, isAdmin bool) (*models.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	jobApplication, deciderId, err := ss.authorizeJobApplication(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 채용 담당자가 없는 지원서는 관리자만 결정할 수 있음
+	if !isAdmin && (jobApplication.User.IsZero() || jobApplication.User != deciderId) {
+		return nil, &errors.CustomError{
+			Message:    "채용 담당자나 관리자만 채용을 결정할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s is not the manager of job application %s", deciderId.Hex(), jobApplication.ID.Hex()),
+		}
+	}
+
+	if !models.IsValidHiringDecision(dto.Decision) {
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 채용 결정",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid hiring decision: %s", dto.Decision),
+		}
+	}
+
+	now := time.Now()
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"decision": models.HiringDecision{
+			Decision:  dto.Decision,
+			Note:      dto.Note,
+			DecidedBy: deciderId,
+			DecidedAt: now,
+		},
+		"updated_at": now,
+	}})
+
+	before := auditSnapshot(ctx, ss.jobApplicationCollection, jobApplication.ID)
+
+	var updatedJobApplication models.JobApplication
+	if err := ss.jobApplicationCollection.FindOneAndUpdate(ctx, versionFilter(ctx, bson.M{"_id": jobApplication.ID, "deleted_at": nil}), update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedJobApplication); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, ss.jobApplicationCollection, jobApplication.ID, findError("JobApplication을 찾을 수 없음", err))
+		}
+		return nil, findError("JobApplication을 찾을 수 없음", err)
+	}
+
+	if err := recordChange(ctx, ss.auditCollection, ss.jobApplicationCollection, models.AuditResourceJobApplication, jobApplication.ID, before); err != nil {
+		return nil, err
+	}
+
+	return &updatedJobApplication, nil
+}
+
+// authorizeJobApplication JobApplication과 요청한 유저 ID를 변환하고 JobApplication을 조회
+func (ss *ScorecardServiceImpl) authorizeJobApplication(ctx context.Context, id string, userId string) (*models.JobApplication, primitive.ObjectID, error) {
+	jobApplicationId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, primitive.NilObjectID, utils.ConvertError("JobApplication", err)
+	}
+
+	currentUserId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, primitive.NilObjectID, utils.ConvertError("User", err)
+	}
+
+	var jobApplication models.JobApplication
+	if err := ss.jobApplicationCollection.FindOne(ctx, bson.M{"_id": jobApplicationId, "deleted_at": nil}).Decode(&jobApplication); err != nil {
+		return nil, primitive.NilObjectID, findError("JobApplication을 찾을 수 없음", err)
+	}
+
+	return &jobApplication, currentUserId, nil
+}
+
+// checkScorecardVisible 다른 면접관의 평가는 본인 평가표를 제출한 뒤에만 볼 수 있음, 채용 담당자는 항상 조회 가능
+func (ss *ScorecardServiceImpl) checkScorecardVisible(ctx context.Context, jobApplication *models.JobApplication, userId primitive.ObjectID) error {
+	if jobApplication.User == userId {
+		return nil
+	}
+
+	count, err := ss.collection.CountDocuments(ctx, bson.M{"job_application": jobApplication.ID, "interviewer": userId})
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if count == 0 {
+		return &errors.CustomError{
+			Message:    "본인 평가표를 제출한 후 조회할 수 있음",
+			StatusCode: http.StatusForbidden,
+			Err:        fmt.Errorf("user %s has not submitted a scorecard", userId.Hex()),
+		}
+	}
+
+	return nil
+}
+
+// findScorecards JobApplication의 평가표를 제출 순으로 조회
+func (ss *ScorecardServiceImpl) findScorecards(ctx context.Context, jobApplicationId primitive.ObjectID) ([]models.Scorecard, error) {
+	var scorecards []models.Scorecard
+
+	opts := options.Find().SetSort(bson.D{{Key: "submitted_at", Value: 1}})
+
+	results, err := ss.collection.Find(ctx, bson.M{"job_application": jobApplicationId}, opts)
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &scorecards); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return scorecards, nil
+}
+
+// scoreRatings 템플릿의 모든 항목이 범위 안의 점수로 한 번씩 평가되었는지 확인하고 0~100 가중 평균 점수를 계산
+func scoreRatings(template *models.ScorecardTemplate, ratingDTOs []dto.ScorecardRatingDTO) ([]models.ScorecardRating, float64, error) {
+	scores := make(map[string]dto.ScorecardRatingDTO, len(ratingDTOs))
+	for _, rating := range ratingDTOs {
+		if _, ok := scores[rating.Criterion]; ok {
+			return nil, 0, &errors.CustomError{
+				Message:    "평가 항목이 중복됨",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("duplicate criterion: %s", rating.Criterion),
+			}
+		}
+		scores[rating.Criterion] = rating
+	}
+
+	if len(scores) != len(template.Criteria) {
+		return nil, 0, &errors.CustomError{
+			Message:    "템플릿의 모든 평가 항목을 평가해야 함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("expected %d ratings, got %d", len(template.Criteria), len(scores)),
+		}
+	}
+
+	ratings := make([]models.ScorecardRating, 0, len(template.Criteria))
+	weighted, totalWeight := 0.0, 0.0
+
+	for _, criterion := range template.Criteria {
+		rating, ok := scores[criterion.Key]
+		if !ok || rating.Score < criterion.ScaleMin || rating.Score > criterion.ScaleMax {
+			return nil, 0, &errors.CustomError{
+				Message:    "평가 항목 점수가 없거나 범위를 벗어남",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("criterion %s requires a score in %d ~ %d", criterion.Key, criterion.ScaleMin, criterion.ScaleMax),
+			}
+		}
+
+		ratings = append(ratings, models.ScorecardRating{
+			Criterion: criterion.Key,
+			Name:      criterion.Name,
+			Score:     rating.Score,
+			Comment:   rating.Comment,
+		})
+
+		normalized := float64(rating.Score-criterion.ScaleMin) / float64(criterion.ScaleMax-criterion.ScaleMin) * 100
+		weighted += normalized * criterion.Weight
+		totalWeight += criterion.Weight
+	}
+
+	score := 0.0
+	if totalWeight > 0 {
+		score = math.Round(weighted/totalWeight*10) / 10
+	}
+
+	return ratings, score, nil
+}
+
+// summarizeScorecards 평가표의 평균 점수, 항목별 평균, 추천 의견 분포를 집계
+func summarizeScorecards(scorecards []models.Scorecard) *models.ScorecardSummary {
+	summary := &models.ScorecardSummary{
+		SubmittedCount:  len(scorecards),
+		Criteria:        []models.CriterionAverage{},
+		Recommendations: map[string]int{},
+	}
+
+	criterionIndex := map[string]int{}
+	criterionTotals := []int{}
+	scoreTotal := 0.0
+
+	for _, scorecard := range scorecards {
+		scoreTotal += scorecard.Score
+		summary.Recommendations[scorecard.Recommendation]++
+
+		for _, rating := range scorecard.Ratings {
+			i, ok := criterionIndex[rating.Criterion]
+			if !ok {
+				i = len(summary.Criteria)
+				criterionIndex[rating.Criterion] = i
+				summary.Criteria = append(summary.Criteria, models.CriterionAverage{Criterion: rating.Criterion, Name: rating.Name})
+				criterionTotals = append(criterionTotals, 0)
+			}
+			summary.Criteria[i].Count++
+			criterionTotals[i] += rating.Score
+		}
+	}
+
+	for i := range summary.Criteria {
+		summary.Criteria[i].Average = math.Round(float64(criterionTotals[i])/float64(summary.Criteria[i].Count)*100) / 100
+	}
+
+	if len(scorecards) > 0 {
+		summary.AverageScore = math.Round(scoreTotal/float64(len(scorecards))*10) / 10
+	}
+
+	summary.Recommendation = models.OverallRecommendation(summary.Recommendations)
+
+	return summary
+}
@@ -0,0 +1,243 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ScorecardTemplateServiceImpl struct {
+	collection *mongo.Collection
+}
+
+func NewScorecardTemplateServiceImpl(collection *mongo.Collection) services.ScorecardTemplateService {
+	return &ScorecardTemplateServiceImpl{collection}
+}
+
+func (sts *ScorecardTemplateServiceImpl) GetAllScorecardTemplate() ([]models.ScorecardTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var templates []models.ScorecardTemplate
+
+	results, err := sts.collection.Find(ctx, bson.M{})
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &templates); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return templates, nil
+}
+
+func (sts *ScorecardTemplateServiceImpl) GetScorecardTemplate(id string) (*models.ScorecardTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("ScorecardTemplate", err)
+	}
+
+	var template models.ScorecardTemplate
+
+	if err := sts.collection.FindOne(ctx, bson.M{"_id": templateId}).Decode(&template); err != nil {
+		return nil, findError("평가표 템플릿을 찾을 수 없음", err)
+	}
+
+	return &template, nil
+}
+
+func (sts *ScorecardTemplateServiceImpl) CreateScorecardTemplate(dto *dto.ScorecardTemplateCreateDTO) (*models.ScorecardTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if dto.Position == "" {
+		return nil, &errors.CustomError{
+			Message:    "포지션이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("position is required"),
+		}
+	}
+
+	criteria, err := convertCriteria(dto.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &models.ScorecardTemplate{
+		ID:        primitive.NewObjectID(),
+		Position:  dto.Position,
+		Name:      dto.Name,
+		Criteria:  criteria,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// 포지션당 템플릿은 position 유니크 인덱스로 하나만 허용
+	if _, err := sts.collection.InsertOne(ctx, template); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &errors.CustomError{
+				Message:    "포지션에 이미 평가표 템플릿이 있음",
+				StatusCode: http.StatusConflict,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return template, nil
+}
+
+func (sts *ScorecardTemplateServiceImpl) UpdateScorecardTemplate(ctx context.Context, id string, dto *dto.ScorecardTemplateUpdateDTO) (*models.ScorecardTemplate, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("ScorecardTemplate", err)
+	}
+
+	template := bson.M{
+		"updated_at": time.Now(),
+	}
+
+	if dto.Name != "" {
+		template["name"] = dto.Name
+	}
+
+	if dto.Criteria != nil {
+		if template["criteria"], err = convertCriteria(dto.Criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := versionFilter(ctx, bson.M{"_id": templateId})
+	update := bumpVersion(bson.M{"$set": template})
+
+	var updatedTemplate models.ScorecardTemplate
+	if err := sts.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedTemplate); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, sts.collection, templateId, findError("평가표 템플릿을 찾을 수 없음", err))
+		}
+		return nil, findError("평가표 템플릿을 찾을 수 없음", err)
+	}
+
+	return &updatedTemplate, nil
+}
+
+func (sts *ScorecardTemplateServiceImpl) DeleteScorecardTemplate(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	templateId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("ScorecardTemplate", err)
+	}
+
+	result, err := sts.collection.DeleteOne(ctx, versionFilter(ctx, bson.M{"_id": templateId}))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return matchError(ctx, sts.collection, templateId, &errors.CustomError{
+			Message:    "평가표 템플릿을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	return nil
+}
+
+// convertCriteria 평가 항목 key 중복과 점수 범위를 확인하고 기본값(1~5, weight 1)을 채움
+func convertCriteria(criterionDTOs []dto.ScorecardCriterionDTO) ([]models.ScorecardCriterion, error) {
+	if len(criterionDTOs) == 0 {
+		return nil, &errors.CustomError{
+			Message:    "평가 항목이 필요함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("criteria are required"),
+		}
+	}
+
+	keys := map[string]bool{}
+	criteria := make([]models.ScorecardCriterion, 0, len(criterionDTOs))
+
+	for _, criterion := range criterionDTOs {
+		if criterion.Key == "" || keys[criterion.Key] {
+			return nil, &errors.CustomError{
+				Message:    "평가 항목 key가 비어 있거나 중복됨",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("invalid criterion key: %q", criterion.Key),
+			}
+		}
+		keys[criterion.Key] = true
+
+		scaleMin, scaleMax := criterion.ScaleMin, criterion.ScaleMax
+		if scaleMin == 0 && scaleMax == 0 {
+			scaleMin, scaleMax = 1, 5
+		}
+
+		if scaleMin >= scaleMax || criterion.Weight < 0 {
+			return nil, &errors.CustomError{
+				Message:    "평가 항목의 점수 범위나 가중치가 올바르지 않음",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("invalid scale for criterion %s: %d ~ %d, weight %v", criterion.Key, scaleMin, scaleMax, criterion.Weight),
+			}
+		}
+
+		weight := criterion.Weight
+		if weight == 0 {
+			weight = 1
+		}
+
+		name := criterion.Name
+		if name == "" {
+			name = criterion.Key
+		}
+
+		criteria = append(criteria, models.ScorecardCriterion{
+			Key:         criterion.Key,
+			Name:        name,
+			Description: criterion.Description,
+			ScaleMin:    scaleMin,
+			ScaleMax:    scaleMax,
+			Weight:      weight,
+		})
+	}
+
+	return criteria, nil
+}
@@ -0,0 +1,573 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 작업 시간 리포트 집계 기준
+const (
+	TimeReportByProject    = "project"
+	TimeReportByUser       = "user"
+	TimeReportByDepartment = "department"
+)
+
+type TimeEntryServiceImpl struct {
+	collection        *mongo.Collection
+	projectCollection *mongo.Collection
+	taskCollection    *mongo.Collection
+	todoCollection    *mongo.Collection
+}
+
+func NewTimeEntryServiceImpl(collection *mongo.Collection, projectCollection *mongo.Collection, taskCollection *mongo.Collection, todoCollection *mongo.Collection) services.TimeEntryService {
+	return &TimeEntryServiceImpl{collection, projectCollection, taskCollection, todoCollection}
+}
+
+func (tes *TimeEntryServiceImpl) GetTimeEntryByUser(userId string, query *dto.TimeEntryQueryDTO) ([]models.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	var entries []models.TimeEntry
+
+	filter := bson.M{"user": actorId}
+	if period := periodFilter(query.From, query.To); len(period) > 0 {
+		filter["start_dt"] = period
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "start_dt", Value: -1}})
+	results, err := tes.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &entries); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return entries, nil
+}
+
+func (tes *TimeEntryServiceImpl) StartTimer(dto *dto.TimeEntryStartDTO, userId string) (*models.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	entry, err := tes.newTimeEntry(ctx, dto.Task, dto.Todo, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Description = dto.Description
+	entry.StartDt = time.Now()
+	entry.Running = true
+
+	// 유저당 실행 중인 타이머는 running 부분 유니크 인덱스로 하나만 허용
+	if _, err := tes.collection.InsertOne(ctx, entry); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, &errors.CustomError{
+				Message:    "이미 실행 중인 타이머가 있음",
+				StatusCode: http.StatusConflict,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return entry, nil
+}
+
+func (tes *TimeEntryServiceImpl) StopTimer(userId string) (*models.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	var entry models.TimeEntry
+
+	filter := bson.M{"user": actorId, "running": true}
+	if err := tes.collection.FindOne(ctx, filter).Decode(&entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "실행 중인 타이머가 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	now := time.Now()
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"end_dt":     now,
+		"minutes":    entryMinutes(entry.StartDt, now),
+		"running":    false,
+		"updated_at": now,
+	}})
+
+	result := tes.collection.FindOneAndUpdate(ctx, bson.M{"_id": entry.ID, "running": true}, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "실행 중인 타이머가 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        result.Err(),
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        result.Err(),
+		}
+	}
+
+	var stoppedEntry *models.TimeEntry
+	if err := result.Decode(&stoppedEntry); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "결과 디코딩 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return stoppedEntry, nil
+}
+
+func (tes *TimeEntryServiceImpl) CreateTimeEntry(dto *dto.TimeEntryCreateDTO, userId string) (*models.TimeEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if dto.StartDt.IsZero() || !dto.EndDt.After(dto.StartDt) {
+		return nil, &errors.CustomError{
+			Message:    "종료 일시는 시작 일시 이후여야 함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid period: %s ~ %s", dto.StartDt, dto.EndDt),
+		}
+	}
+
+	entry, err := tes.newTimeEntry(ctx, dto.Task, dto.Todo, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	endDt := dto.EndDt
+	entry.Description = dto.Description
+	entry.StartDt = dto.StartDt
+	entry.EndDt = &endDt
+	entry.Minutes = entryMinutes(dto.StartDt, dto.EndDt)
+
+	if _, err := tes.collection.InsertOne(ctx, entry); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return entry, nil
+}
+
+func (tes *TimeEntryServiceImpl) DeleteTimeEntry(ctx context.Context, id string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	entryId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("TimeEntry", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	// 본인 기록만 삭제 가능
+	filter := bson.M{"_id": entryId, "user": actorId}
+
+	result, err := tes.collection.DeleteOne(ctx, versionFilter(ctx, filter))
+	if err != nil {
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if result.DeletedCount == 0 {
+		return scopedMatchError(ctx, tes.collection, bson.M{"_id": entryId, "user": actorId}, &errors.CustomError{
+			Message:    "작업 시간 기록을 찾을 수 없음",
+			StatusCode: http.StatusNotFound,
+			Err:        mongo.ErrNoDocuments,
+		})
+	}
+
+	return nil
+}
+
+func (tes *TimeEntryServiceImpl) GetTimeReport(query *dto.TimeReportQueryDTO, userId string) ([]models.TimeReportRow, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if query.From.IsZero() || !query.To.After(query.From) {
+		return nil, &errors.CustomError{
+			Message:    "조회 기간이 올바르지 않음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid period: %s ~ %s", query.From, query.To),
+		}
+	}
+
+	var groupKey, from, nameField string
+	switch query.GroupBy {
+	case TimeReportByProject, "":
+		groupKey, from, nameField = "$project", "projects", "name"
+	case TimeReportByUser:
+		groupKey, from, nameField = "$user", "users", "user_name"
+	case TimeReportByDepartment:
+		groupKey, from, nameField = "$department", "departments", "name"
+	default:
+		return nil, &errors.CustomError{
+			Message:    "유효하지 않은 집계 기준",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("invalid group_by: %s", query.GroupBy),
+		}
+	}
+
+	// 조회할 수 있는 Project의 기록만 집계
+	readableMatch, err := readableProjectMatch(ctx, tes.projectCollection, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	matchStage := bson.D{{Key: "$match", Value: bson.D{
+		{Key: "running", Value: false},
+		{Key: "start_dt", Value: periodFilter(query.From, query.To)},
+		{Key: "$and", Value: bson.A{readableMatch}},
+	}}}
+
+	pipeline := mongo.Pipeline{matchStage}
+
+	// 부서는 기록 시점이 아닌 유저의 현재 부서 기준
+	if query.GroupBy == TimeReportByDepartment {
+		pipeline = append(pipeline,
+			bson.D{{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "users"},
+				{Key: "localField", Value: "user"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "user_info"},
+			}}},
+			bson.D{{Key: "$addFields", Value: bson.D{
+				{Key: "department", Value: bson.D{{Key: "$first", Value: "$user_info.department"}}},
+			}}},
+		)
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: groupKey},
+			{Key: "entries", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "minutes", Value: bson.D{{Key: "$sum", Value: "$minutes"}}},
+		}}},
+		bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: from},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "info"},
+		}}},
+		bson.D{{Key: "$addFields", Value: bson.D{
+			{Key: "name", Value: bson.D{{Key: "$first", Value: "$info." + nameField}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "minutes", Value: -1}}}},
+	)
+
+	var rows []models.TimeReportRow
+
+	results, err := tes.collection.Aggregate(ctx, pipeline)
+
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &rows); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	for i := range rows {
+		rows[i].Hours = math.Round(float64(rows[i].Minutes)/60*100) / 100
+	}
+
+	return rows, nil
+}
+
+func (tes *TimeEntryServiceImpl) GetProjectTimeEstimates(id string, userId string) ([]models.TimeEstimate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	projectId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("Project", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if _, err := checkProjectAccess(ctx, tes.projectCollection, projectId, actorId, projectRead); err != nil {
+		return nil, err
+	}
+
+	tasks, err := projectTasks(ctx, tes.taskCollection, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var todos []models.Todo
+
+	results, err := tes.todoCollection.Find(ctx, bson.M{"project": projectId, "deleted_at": nil})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &todos); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	actuals, err := tes.actualMinutes(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	estimates := []models.TimeEstimate{}
+
+	for _, task := range tasks {
+		estimates = append(estimates, models.TimeEstimate{
+			ID:              task.ID,
+			Type:            models.TimeEstimateTypeTask,
+			Name:            task.TaskDescription,
+			Status:          task.Status,
+			EstimateMinutes: task.EstimateMinutes,
+			ActualMinutes:   actuals[task.ID],
+			VarianceMinutes: actuals[task.ID] - task.EstimateMinutes,
+		})
+	}
+
+	for _, todo := range todos {
+		estimates = append(estimates, models.TimeEstimate{
+			ID:              todo.ID,
+			Type:            models.TimeEstimateTypeTodo,
+			Name:            todo.Task,
+			Status:          todo.Status,
+			EstimateMinutes: todo.EstimateMinutes,
+			ActualMinutes:   actuals[todo.ID],
+			VarianceMinutes: actuals[todo.ID] - todo.EstimateMinutes,
+		})
+	}
+
+	return estimates, nil
+}
+
+// actualMinutes Project의 Task/Todo별 기록된 시간(분)
+func (tes *TimeEntryServiceImpl) actualMinutes(ctx context.Context, projectId primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "project", Value: projectId}, {Key: "running", Value: false}}}}
+
+	groupStage := bson.D{{Key: "$group", Value: bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$task", "$todo"}}}},
+		{Key: "minutes", Value: bson.D{{Key: "$sum", Value: "$minutes"}}},
+	}}}
+
+	var sums []struct {
+		ID      primitive.ObjectID `bson:"_id"`
+		Minutes int64              `bson:"minutes"`
+	}
+
+	results, err := tes.collection.Aggregate(ctx, mongo.Pipeline{matchStage, groupStage})
+	if err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &sums); err != nil {
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	actuals := map[primitive.ObjectID]int64{}
+	for _, sum := range sums {
+		actuals[sum.ID] = sum.Minutes
+	}
+
+	return actuals, nil
+}
+
+// newTimeEntry 기록 대상(Project Task 또는 Todo)을 확인하고 연결 정보를 채운 기록을 생성
+// 기록 대상을 수정할 수 있는 유저만 시간을 기록할 수 있음
+func (tes *TimeEntryServiceImpl) newTimeEntry(ctx context.Context, task string, todo string, userId primitive.ObjectID) (*models.TimeEntry, error) {
+	if (task == "") == (todo == "") {
+		return nil, &errors.CustomError{
+			Message:    "Project Task와 Todo 중 하나만 지정해야 함",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("exactly one of task or todo is required"),
+		}
+	}
+
+	entry := &models.TimeEntry{
+		ID:        primitive.NewObjectID(),
+		User:      userId,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if task != "" {
+		taskId, err := utils.ConvertToObjectId(task)
+		if err != nil {
+			return nil, utils.ConvertError("ProjectTask", err)
+		}
+
+		var projectTask models.ProjectTask
+		if err := tes.taskCollection.FindOne(ctx, bson.M{"_id": taskId, "deleted_at": nil}).Decode(&projectTask); err != nil {
+			return nil, findError("Project Task를 찾을 수 없음", err)
+		}
+
+		if _, err := checkProjectAccess(ctx, tes.projectCollection, projectTask.Project, userId, projectWrite); err != nil {
+			return nil, err
+		}
+
+		entry.Task = taskId
+		entry.Project = projectTask.Project
+		return entry, nil
+	}
+
+	todoId, err := utils.ConvertToObjectId(todo)
+	if err != nil {
+		return nil, utils.ConvertError("Todo", err)
+	}
+
+	var todoDoc models.Todo
+	if err := tes.todoCollection.FindOne(ctx, bson.M{"_id": todoId, "deleted_at": nil}).Decode(&todoDoc); err != nil {
+		return nil, findError("TODO를 찾을 수 없음", err)
+	}
+
+	if !todoDoc.Project.IsZero() {
+		if _, err := checkProjectAccess(ctx, tes.projectCollection, todoDoc.Project, userId, projectWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.Todo = todoId
+	entry.Project = todoDoc.Project
+	return entry, nil
+}
+
+// findError FindOne 오류를 문서가 없으면 404, 그 외에는 500으로 변환
+func findError(notFoundMessage string, err error) error {
+	if err == mongo.ErrNoDocuments {
+		return &errors.CustomError{
+			Message:    notFoundMessage,
+			StatusCode: http.StatusNotFound,
+			Err:        err,
+		}
+	}
+	return &errors.CustomError{
+		Message:    "내부 서버 오류",
+		StatusCode: http.StatusInternalServerError,
+		Err:        err,
+	}
+}
+
+// periodFilter [from, to) 기간 조건, 비어 있는 쪽은 제한하지 않음
+func periodFilter(from time.Time, to time.Time) bson.M {
+	period := bson.M{}
+	if !from.IsZero() {
+		period["$gte"] = from
+	}
+	if !to.IsZero() {
+		period["$lt"] = to
+	}
+	return period
+}
+
+// entryMinutes 시작부터 종료까지의 분
+func entryMinutes(startDt time.Time, endDt time.Time) int64 {
+	return int64(endDt.Sub(startDt) / time.Minute)
+}
@@ -18,19 +18,37 @@ import (
 )
 
 type TodoServiceImpl struct {
-	collection *mongo.Collection
+	collection        *mongo.Collection
+	projectCollection *mongo.Collection
+	auditCollection   *mongo.Collection
 }
 
-func NewTodoServiceImpl(collection *mongo.Collection) services.TodoService {
-	return &TodoServiceImpl{collection}
+func NewTodoServiceImpl(collection *mongo.Collection, projectCollection *mongo.Collection, auditCollection *mongo.Collection) services.TodoService {
+	return &TodoServiceImpl{collection, projectCollection, auditCollection}
 }
 
-func (ts *TodoServiceImpl) GetAllTodo() ([]models.Todo, error) {
+func (ts *TodoServiceImpl) GetAllTodo(userId string) ([]models.Todo, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
 	var todos []models.Todo
 
+	// Project에 연결된 Todo는 Project 멤버에게만 노출
+	projectMatch, err := readableProjectMatch(ctx, ts.projectCollection, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	matchStage := bson.D{{Key: "$match", Value: bson.D{
+		{Key: "deleted_at", Value: nil},
+		{Key: "$and", Value: bson.A{projectMatch}},
+	}}}
+
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
 		{Key: "localField", Value: "user"},
@@ -52,7 +70,15 @@ func (ts *TodoServiceImpl) GetAllTodo() ([]models.Todo, error) {
 		{Key: "as", Value: "department_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{lookupUserStage, lookupProjectStage, lookupDepartmentStage}
+	lookupMeetingStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "meetings"},
+		{Key: "localField", Value: "meeting"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "meeting_info"},
+	}}}
+
+	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage, lookupMeetingStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetTodo)...)
 
 	results, err := ts.collection.Aggregate(ctx, pipeline)
 
@@ -77,7 +103,7 @@ func (ts *TodoServiceImpl) GetAllTodo() ([]models.Todo, error) {
 	return todos, nil
 }
 
-func (ts *TodoServiceImpl) GetTodo(id string) (*models.Todo, error) {
+func (ts *TodoServiceImpl) GetTodo(id string, userId string) (*models.Todo, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -86,9 +112,14 @@ func (ts *TodoServiceImpl) GetTodo(id string) (*models.Todo, error) {
 		return nil, utils.ConvertError("Todo", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
 	var todo *models.Todo
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: todoId}}}}
+	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: todoId}, {Key: "deleted_at", Value: nil}}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -111,7 +142,15 @@ func (ts *TodoServiceImpl) GetTodo(id string) (*models.Todo, error) {
 		{Key: "as", Value: "department_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage}
+	lookupMeetingStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "meetings"},
+		{Key: "localField", Value: "meeting"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "meeting_info"},
+	}}}
+
+	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage, lookupMeetingStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetTodo)...)
 
 	result, err := ts.collection.Aggregate(ctx, pipeline)
 
@@ -133,12 +172,18 @@ func (ts *TodoServiceImpl) GetTodo(id string) (*models.Todo, error) {
 				Err:        err,
 			}
 		}
+
+		if !todo.Project.IsZero() {
+			if _, err := checkProjectAccess(ctx, ts.projectCollection, todo.Project, actorId, projectRead); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return todo, nil
 }
 
-func (ts *TodoServiceImpl) GetTodoByUser(id string) ([]models.Todo, error) {
+func (ts *TodoServiceImpl) GetTodoByUser(id string, actor string) ([]models.Todo, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -147,9 +192,24 @@ func (ts *TodoServiceImpl) GetTodoByUser(id string) ([]models.Todo, error) {
 		return nil, utils.ConvertError("User", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(actor)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
 	var todos []models.Todo
 
-	matchStage := bson.D{{Key: "$match", Value: bson.D{{Key: "user", Value: userId}}}}
+	// Project에 연결된 Todo는 Project 멤버에게만 노출
+	projectMatch, err := readableProjectMatch(ctx, ts.projectCollection, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	matchStage := bson.D{{Key: "$match", Value: bson.D{
+		{Key: "user", Value: userId},
+		{Key: "deleted_at", Value: nil},
+		{Key: "$and", Value: bson.A{projectMatch}},
+	}}}
 
 	lookupUserStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "users"},
@@ -172,7 +232,15 @@ func (ts *TodoServiceImpl) GetTodoByUser(id string) ([]models.Todo, error) {
 		{Key: "as", Value: "department_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage}
+	lookupMeetingStage := bson.D{{Key: "$lookup", Value: bson.D{
+		{Key: "from", Value: "meetings"},
+		{Key: "localField", Value: "meeting"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "meeting_info"},
+	}}}
+
+	pipeline := mongo.Pipeline{matchStage, lookupUserStage, lookupProjectStage, lookupDepartmentStage, lookupMeetingStage}
+	pipeline = append(pipeline, commentCountStages(models.CommentTargetTodo)...)
 
 	results, err := ts.collection.Aggregate(ctx, pipeline)
 
@@ -197,20 +265,21 @@ func (ts *TodoServiceImpl) GetTodoByUser(id string) ([]models.Todo, error) {
 	return todos, nil
 }
 
-func (ts *TodoServiceImpl) CreateTodo(dto *dto.TodoCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ts *TodoServiceImpl) CreateTodo(ctx context.Context, dto *dto.TodoCreateDTO, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
 
 	todo := models.Todo{
-		ID:        primitive.NewObjectID(),
-		Task:      dto.Task,
-		Status:    dto.Status,
-		StartDt:   dto.StartDt,
-		EndDt:     dto.EndDt,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:              primitive.NewObjectID(),
+		Task:            dto.Task,
+		Status:          dto.Status,
+		StartDt:         dto.StartDt,
+		EndDt:           dto.EndDt,
+		EstimateMinutes: dto.EstimateMinutes,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	var err error
@@ -219,6 +288,17 @@ func (ts *TodoServiceImpl) CreateTodo(dto *dto.TodoCreateDTO) error {
 		return utils.ConvertError("Project", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	if !todo.Project.IsZero() {
+		if _, err := checkProjectAccess(ctx, ts.projectCollection, todo.Project, actorId, projectWrite); err != nil {
+			return err
+		}
+	}
+
 	if todo.User, err = utils.ConvertToObjectId(dto.User); err != nil {
 		return utils.ConvertError("User", err)
 	}
@@ -239,11 +319,15 @@ func (ts *TodoServiceImpl) CreateTodo(dto *dto.TodoCreateDTO) error {
 		}
 	}
 
+	if err := recordChange(ctx, ts.auditCollection, ts.collection, models.AuditResourceTodo, todo.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (ts *TodoServiceImpl) UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*models.Todo, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ts *TodoServiceImpl) UpdateTodo(ctx context.Context, id string, dto *dto.TodoUpdateDTO, userId string) (*models.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	todoId, err := utils.ConvertToObjectId(id)
@@ -251,6 +335,15 @@ func (ts *TodoServiceImpl) UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*model
 		return nil, utils.ConvertError("Todo", err)
 	}
 
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if _, err := ts.authorizeTodo(ctx, todoId, actorId); err != nil {
+		return nil, err
+	}
+
 	todo := bson.M{
 		"updated_at": time.Now(),
 	}
@@ -271,6 +364,10 @@ func (ts *TodoServiceImpl) UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*model
 		todo["end_dt"] = dto.EndDt
 	}
 
+	if dto.EstimateMinutes > 0 {
+		todo["estimate_minutes"] = dto.EstimateMinutes
+	}
+
 	if dto.Department != "" {
 		if todo["department"], err = utils.ConvertToObjectId(dto.Department); err != nil {
 			return nil, utils.ConvertError("Department", err)
@@ -278,24 +375,34 @@ func (ts *TodoServiceImpl) UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*model
 	}
 
 	if dto.Project != "" {
-		if todo["project"], err = utils.ConvertToObjectId(dto.Project); err != nil {
+		projectId, err := utils.ConvertToObjectId(dto.Project)
+		if err != nil {
 			return nil, utils.ConvertError("Project", err)
 		}
+
+		// 다른 Project로 옮길 때는 옮길 Project의 수정 권한도 필요
+		if _, err := checkProjectAccess(ctx, ts.projectCollection, projectId, actorId, projectWrite); err != nil {
+			return nil, err
+		}
+
+		todo["project"] = projectId
 	}
 
-	filter := bson.M{"_id": todoId}
-	update := bson.M{"$set": todo}
+	before := auditSnapshot(ctx, ts.collection, todoId)
+
+	filter := versionFilter(ctx, bson.M{"_id": todoId, "deleted_at": nil})
+	update := bumpVersion(bson.M{"$set": todo})
 
 	fmt.Printf("todo: %+v", todo)
 
 	result := ts.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
-			return nil, &errors.CustomError{
+			return nil, matchError(ctx, ts.collection, todoId, &errors.CustomError{
 				Message:    "TODO를 찾을 수 없음",
 				StatusCode: http.StatusNotFound,
 				Err:        result.Err(),
-			}
+			})
 		}
 		return nil, &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -313,11 +420,15 @@ func (ts *TodoServiceImpl) UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*model
 		}
 	}
 
+	if err := recordChange(ctx, ts.auditCollection, ts.collection, models.AuditResourceTodo, todoId, before); err != nil {
+		return nil, err
+	}
+
 	return updatedTodo, nil
 }
 
-func (ts *TodoServiceImpl) DeleteTodo(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (ts *TodoServiceImpl) DeleteTodo(ctx context.Context, id string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	todoId, err := utils.ConvertToObjectId(id)
@@ -325,9 +436,20 @@ func (ts *TodoServiceImpl) DeleteTodo(id string) error {
 		return utils.ConvertError("Todo", err)
 	}
 
-	filter := bson.M{"_id": todoId}
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
 
-	result, err := ts.collection.DeleteOne(ctx, filter)
+	if _, err := ts.authorizeTodo(ctx, todoId, actorId); err != nil {
+		return err
+	}
+
+	before := auditSnapshot(ctx, ts.collection, todoId)
+
+	filter := versionFilter(ctx, bson.M{"_id": todoId})
+
+	result, err := softDelete(ctx, ts.collection, filter, nil)
 	if err != nil {
 		return &errors.CustomError{
 			Message:    "내부 서버 오류",
@@ -336,13 +458,45 @@ func (ts *TodoServiceImpl) DeleteTodo(id string) error {
 		}
 	}
 
-	if result.DeletedCount == 0 {
-		return &errors.CustomError{
+	if result.MatchedCount == 0 {
+		return matchError(ctx, ts.collection, todoId, &errors.CustomError{
 			Message:    "TODO를 찾을 수 없음",
 			StatusCode: http.StatusNotFound,
 			Err:        mongo.ErrNoDocuments,
-		}
+		})
+	}
+
+	if err := recordAudit(ctx, ts.auditCollection, models.AuditResourceTodo, models.AuditActionDelete, todoId, before, auditSnapshot(ctx, ts.collection, todoId)); err != nil {
+		return err
 	}
 
 	return nil
 }
+
+// authorizeTodo Todo를 조회하여 Project에 연결된 경우 유저가 해당 Project의 수정 권한을 가지는지 확인
+func (ts *TodoServiceImpl) authorizeTodo(ctx context.Context, todoId primitive.ObjectID, userId primitive.ObjectID) (*models.Todo, error) {
+	var todo models.Todo
+
+	if err := ts.collection.FindOne(ctx, bson.M{"_id": todoId, "deleted_at": nil}).Decode(&todo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, &errors.CustomError{
+				Message:    "TODO를 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return nil, &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if !todo.Project.IsZero() {
+		if _, err := checkProjectAccess(ctx, ts.projectCollection, todo.Project, userId, projectWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	return &todo, nil
+}
@@ -0,0 +1,413 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/audit"
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+	"github.com/Kim-DaeHan/all-note-golang/services"
+	"github.com/Kim-DaeHan/all-note-golang/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 삭제되지 않은 문서만 남기는 aggregate 단계, deleted_at이 없거나 null
+var notDeletedStage = bson.D{{Key: "$match", Value: bson.D{{Key: "deleted_at", Value: nil}}}}
+
+// trashSource 휴지통 리소스별 컬렉션과 제목/소유자 필드,
+// 영구 삭제 시 함께 지울 댓글 대상 종류와 시간 기록 필드 (없으면 빈 문자열)
+type trashSource struct {
+	resource       string
+	collection     *mongo.Collection
+	titleField     string
+	ownerField     string
+	commentTarget  string
+	timeEntryField string
+}
+
+type TrashServiceImpl struct {
+	noteCollection           *mongo.Collection
+	todoCollection           *mongo.Collection
+	projectCollection        *mongo.Collection
+	taskCollection           *mongo.Collection
+	meetingCollection        *mongo.Collection
+	jobApplicationCollection *mongo.Collection
+	commentCollection        *mongo.Collection
+	timeEntryCollection      *mongo.Collection
+	notificationCollection   *mongo.Collection
+	auditCollection          *mongo.Collection
+}
+
+func NewTrashServiceImpl(noteCollection *mongo.Collection, todoCollection *mongo.Collection, projectCollection *mongo.Collection, taskCollection *mongo.Collection, meetingCollection *mongo.Collection, jobApplicationCollection *mongo.Collection, commentCollection *mongo.Collection, timeEntryCollection *mongo.Collection, notificationCollection *mongo.Collection, auditCollection *mongo.Collection) services.TrashService {
+	return &TrashServiceImpl{noteCollection, todoCollection, projectCollection, taskCollection, meetingCollection, jobApplicationCollection, commentCollection, timeEntryCollection, notificationCollection, auditCollection}
+}
+
+func (ts *TrashServiceImpl) GetTrash(query *dto.TrashQueryDTO, userId string) ([]models.TrashItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if query.Resource != "" && !models.IsTrashResource(query.Resource) {
+		return nil, invalidTrashResource(query.Resource)
+	}
+
+	retention := trashRetention()
+	items := []models.TrashItem{}
+
+	for _, source := range ts.sources() {
+		if query.Resource != "" && query.Resource != source.resource {
+			continue
+		}
+
+		// Project와 함께 삭제된 Task/Todo는 Project를 복원할 때 함께 복원되므로 따로 보여주지 않음
+		matchStage := bson.D{{Key: "$match", Value: bson.D{
+			{Key: "deleted_at", Value: bson.D{{Key: "$ne", Value: nil}}},
+			{Key: "deleted_with", Value: bson.D{{Key: "$exists", Value: false}}},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "deleted_by", Value: actorId}},
+				bson.D{{Key: source.ownerField, Value: actorId}},
+			}},
+		}}}
+
+		projectStage := bson.D{{Key: "$project", Value: bson.D{
+			{Key: "title", Value: "$" + source.titleField},
+			{Key: "deleted_at", Value: 1},
+			{Key: "deleted_by", Value: 1},
+		}}}
+
+		results, err := source.collection.Aggregate(ctx, mongo.Pipeline{matchStage, projectStage})
+		if err != nil {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		var sourceItems []models.TrashItem
+		err = results.All(ctx, &sourceItems)
+		results.Close(ctx)
+		if err != nil {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		for _, item := range sourceItems {
+			item.Resource = source.resource
+			item.PurgeAt = item.DeletedAt.Add(retention)
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+func (ts *TrashServiceImpl) RestoreTrashItem(ctx context.Context, resource string, id string, userId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	source, ok := ts.source(resource)
+	if !ok {
+		return invalidTrashResource(resource)
+	}
+
+	itemId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("Resource", err)
+	}
+
+	actorId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	// 직접 삭제했거나 소유한 항목만 복원 가능, 그 외에는 존재 여부도 드러내지 않음
+	filter := bson.M{
+		"_id":        itemId,
+		"deleted_at": bson.M{"$ne": nil},
+		"$or": bson.A{
+			bson.M{"deleted_by": actorId},
+			bson.M{source.ownerField: actorId},
+		},
+	}
+
+	var item struct {
+		Project     primitive.ObjectID  `bson:"project,omitempty"`
+		DeletedWith *primitive.ObjectID `bson:"deleted_with,omitempty"`
+	}
+
+	if err := source.collection.FindOne(ctx, filter).Decode(&item); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &errors.CustomError{
+				Message:    "휴지통에서 항목을 찾을 수 없음",
+				StatusCode: http.StatusNotFound,
+				Err:        err,
+			}
+		}
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if item.DeletedWith != nil {
+		return &errors.CustomError{
+			Message:    "Project와 함께 삭제된 항목은 Project를 복원해야 함",
+			StatusCode: http.StatusConflict,
+			Err:        fmt.Errorf("%s %s was deleted with project %s", resource, id, item.DeletedWith.Hex()),
+		}
+	}
+
+	// 휴지통에 있는 Project의 Task/Todo를 복원하면 어디에서도 보이지 않으므로 Project를 먼저 복원해야 함
+	if (resource == models.AuditResourceTodo || resource == models.AuditResourceProjectTask) && !item.Project.IsZero() {
+		count, err := ts.projectCollection.CountDocuments(ctx, bson.M{"_id": item.Project, "deleted_at": nil})
+		if err != nil {
+			return &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+		if count == 0 {
+			return &errors.CustomError{
+				Message:    "Project가 휴지통에 있어 먼저 복원해야 함",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("project %s is deleted", item.Project.Hex()),
+			}
+		}
+	}
+
+	before := auditSnapshot(ctx, source.collection, itemId)
+
+	if resource == models.AuditResourceProject {
+		err = ts.restoreProject(ctx, itemId)
+	} else {
+		_, err = source.collection.UpdateOne(ctx, bson.M{"_id": itemId}, restoreUpdate)
+	}
+
+	if err != nil {
+		if customErr, ok := err.(*errors.CustomError); ok {
+			return customErr
+		}
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if err := recordAudit(ctx, ts.auditCollection, resource, models.AuditActionRestore, itemId, before, auditSnapshot(ctx, source.collection, itemId)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TrashServiceImpl) PurgeTrash() (*models.TrashPurgeResult, error) {
+	// 리소스마다 삭제 대상을 모두 처리하므로 조회보다 긴 시간 허용
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	cutoff := time.Now().Add(-trashRetention())
+	result := &models.TrashPurgeResult{Purged: map[string]int{}}
+
+	for _, source := range ts.sources() {
+		ids, err := source.collection.Distinct(ctx, "_id", bson.M{"deleted_at": bson.M{"$lte": cutoff}})
+		if err != nil {
+			return nil, &errors.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		// 조회와 삭제 사이에 복원된 문서는 남겨두고, 실제로 삭제된 문서만 집계하고 감사 로그에 남김
+		purged := 0
+		for _, id := range ids {
+			objectId, ok := id.(primitive.ObjectID)
+			if !ok {
+				continue
+			}
+
+			deleted, err := ts.purgeItem(ctx, source, objectId, cutoff)
+			if err != nil {
+				return nil, &errors.CustomError{
+					Message:    "내부 서버 오류",
+					StatusCode: http.StatusInternalServerError,
+					Err:        err,
+				}
+			}
+
+			if !deleted {
+				continue
+			}
+
+			purged++
+			if err := recordAudit(ctx, ts.auditCollection, source.resource, models.AuditActionPurge, objectId, nil, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		result.Purged[source.resource] = purged
+	}
+
+	return result, nil
+}
+
+// purgeItem 트랜잭션 안에서 문서와 문서를 가리키는 댓글, 댓글 알림, 시간 기록을 함께 영구 삭제
+func (ts *TrashServiceImpl) purgeItem(ctx context.Context, source trashSource, id primitive.ObjectID, cutoff time.Time) (bool, error) {
+	session, err := source.collection.Database().Client().StartSession()
+	if err != nil {
+		return false, err
+	}
+
+	defer session.EndSession(ctx)
+
+	deleted, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		result, err := source.collection.DeleteOne(sessCtx, bson.M{"_id": id, "deleted_at": bson.M{"$lte": cutoff}})
+		if err != nil {
+			return false, err
+		}
+
+		if result.DeletedCount == 0 {
+			return false, nil
+		}
+
+		if source.commentTarget != "" {
+			target := bson.M{"target_type": source.commentTarget, "target": id}
+
+			if _, err := ts.notificationCollection.DeleteMany(sessCtx, target); err != nil {
+				return false, err
+			}
+
+			if _, err := ts.commentCollection.DeleteMany(sessCtx, target); err != nil {
+				return false, err
+			}
+		}
+
+		if source.timeEntryField != "" {
+			if _, err := ts.timeEntryCollection.DeleteMany(sessCtx, bson.M{source.timeEntryField: id}); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted.(bool), nil
+}
+
+// restoreProject 트랜잭션 안에서 Project와 함께 삭제된 Task/Todo를 함께 복원
+func (ts *TrashServiceImpl) restoreProject(ctx context.Context, projectId primitive.ObjectID) error {
+	session, err := ts.projectCollection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		filter := bson.M{"project": projectId, "deleted_with": projectId}
+
+		if _, err := ts.taskCollection.UpdateMany(sessCtx, filter, restoreUpdate); err != nil {
+			return nil, err
+		}
+
+		if _, err := ts.todoCollection.UpdateMany(sessCtx, filter, restoreUpdate); err != nil {
+			return nil, err
+		}
+
+		return ts.projectCollection.UpdateOne(sessCtx, bson.M{"_id": projectId}, restoreUpdate)
+	})
+
+	return err
+}
+
+func (ts *TrashServiceImpl) sources() []trashSource {
+	return []trashSource{
+		{models.AuditResourceNote, ts.noteCollection, "text", "author", "", ""},
+		{models.AuditResourceTodo, ts.todoCollection, "task", "user", models.CommentTargetTodo, "todo"},
+		{models.AuditResourceProject, ts.projectCollection, "name", "owner", "", "project"},
+		{models.AuditResourceProjectTask, ts.taskCollection, "task_description", "manager", models.CommentTargetProjectTask, "task"},
+		{models.AuditResourceMeeting, ts.meetingCollection, "title", "created_by", "", ""},
+		{models.AuditResourceJobApplication, ts.jobApplicationCollection, "applicant_name", "manager", models.CommentTargetJobApplication, ""},
+	}
+}
+
+func (ts *TrashServiceImpl) source(resource string) (trashSource, bool) {
+	for _, source := range ts.sources() {
+		if source.resource == resource {
+			return source, true
+		}
+	}
+	return trashSource{}, false
+}
+
+// 휴지통에서 꺼내는 update, 삭제 정보만 지우고 updated_at은 그대로 둠
+var restoreUpdate = bson.M{
+	"$unset": bson.M{"deleted_at": "", "deleted_by": "", "deleted_with": ""},
+	"$inc":   bson.M{"version": 1},
+}
+
+// softDelete filter에 맞는 삭제되지 않은 문서에 삭제 일시와 삭제한 유저를 기록
+// Project와 함께 삭제하는 경우 deletedWith에 Project ID를 남김
+func softDelete(ctx context.Context, collection *mongo.Collection, filter bson.M, deletedWith *primitive.ObjectID) (*mongo.UpdateResult, error) {
+	trashFilter := bson.M{"deleted_at": nil}
+	for key, value := range filter {
+		trashFilter[key] = value
+	}
+
+	set := bson.M{"deleted_at": time.Now()}
+	if actor := audit.FromContext(ctx).Actor; actor != nil {
+		set["deleted_by"] = *actor
+	}
+	if deletedWith != nil {
+		set["deleted_with"] = *deletedWith
+	}
+
+	return collection.UpdateMany(ctx, trashFilter, bumpVersion(bson.M{"$set": set}))
+}
+
+// trashRetention 휴지통 보관 기간 (TRASH_RETENTION_DAYS, 기본 30일)
+func trashRetention() time.Duration {
+	if n, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); err == nil && n > 0 {
+		return time.Duration(n) * 24 * time.Hour
+	}
+	return 30 * 24 * time.Hour
+}
+
+func invalidTrashResource(resource string) error {
+	return &errors.CustomError{
+		Message:    "휴지통을 지원하지 않는 리소스",
+		StatusCode: http.StatusBadRequest,
+		Err:        fmt.Errorf("invalid trash resource: %s", resource),
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Kim-DaeHan/all-note-golang/dto"
@@ -19,19 +22,36 @@ import (
 )
 
 type UserServiceImpl struct {
-	collection *mongo.Collection
+	collection             *mongo.Collection
+	noteCollection         *mongo.Collection
+	todoCollection         *mongo.Collection
+	meetingCollection      *mongo.Collection
+	projectTaskCollection  *mongo.Collection
+	projectCollection      *mongo.Collection
+	commentCollection      *mongo.Collection
+	timeEntryCollection    *mongo.Collection
+	notificationCollection *mongo.Collection
+	auditCollection        *mongo.Collection
 }
 
-func NewUserServiceImpl(collection *mongo.Collection) services.UserService {
-	return &UserServiceImpl{collection}
+func NewUserServiceImpl(collection *mongo.Collection, noteCollection *mongo.Collection, todoCollection *mongo.Collection, meetingCollection *mongo.Collection, projectTaskCollection *mongo.Collection, projectCollection *mongo.Collection, commentCollection *mongo.Collection, timeEntryCollection *mongo.Collection, notificationCollection *mongo.Collection, auditCollection *mongo.Collection) services.UserService {
+	return &UserServiceImpl{collection, noteCollection, todoCollection, meetingCollection, projectTaskCollection, projectCollection, commentCollection, timeEntryCollection, notificationCollection, auditCollection}
 }
 
-func (us *UserServiceImpl) GetAllUser() ([]models.User, error) {
+func (us *UserServiceImpl) GetAllUser(query *dto.UserQueryDTO) ([]models.User, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	var users []models.User
 
+	filter, err := us.userFilter(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	matchStage := bson.D{{Key: "$match", Value: filter}}
+	sortStage := bson.D{{Key: "$sort", Value: bson.D{{Key: "user_name", Value: 1}}}}
+
 	lookupStage := bson.D{{Key: "$lookup", Value: bson.D{
 		{Key: "from", Value: "departments"},
 		{Key: "localField", Value: "department"},
@@ -39,12 +59,13 @@ func (us *UserServiceImpl) GetAllUser() ([]models.User, error) {
 		{Key: "as", Value: "department_info"},
 	}}}
 
-	pipeline := mongo.Pipeline{lookupStage}
+	pipeline := mongo.Pipeline{matchStage, sortStage}
+	if query.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: min(query.Limit, userSearchMaxLimit)}})
+	}
+	pipeline = append(pipeline, lookupStage)
 
-	// query := bson.M{}
-	// results, err := us.collection.Find(ctx, query)
 	results, err := us.collection.Aggregate(ctx, pipeline)
-	// err := us.collection.FindOne(ctx, query).Decode(&users)
 
 	if err != nil {
 		return nil, &anErr.CustomError{
@@ -68,6 +89,56 @@ func (us *UserServiceImpl) GetAllUser() ([]models.User, error) {
 	return users, nil
 }
 
+// 검색 결과 기본/최대 개수
+const (
+	userSearchLimit    = 20
+	userSearchMaxLimit = 100
+)
+
+func (us *UserServiceImpl) SearchUsers(query *dto.UserQueryDTO) ([]models.UserSummary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	users := []models.UserSummary{}
+
+	filter, err := us.userFilter(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := min(query.Limit, userSearchMaxLimit)
+	if limit <= 0 {
+		limit = userSearchLimit
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1, "user_name": 1, "photo": 1, "position": 1}).
+		SetSort(bson.D{{Key: "user_name", Value: 1}}).
+		SetLimit(int64(limit))
+
+	results, err := us.collection.Find(ctx, filter, opts)
+
+	if err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer results.Close(ctx)
+
+	if err = results.All(ctx, &users); err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return users, nil
+}
+
 func (us *UserServiceImpl) GetUser(id string) (*models.User, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -103,7 +174,6 @@ func (us *UserServiceImpl) GetUser(id string) (*models.User, error) {
 	defer result.Close(ctx)
 
 	if result.Next(ctx) {
-		fmt.Println("result: ", result)
 		if err := result.Decode(&users); err != nil {
 			return nil, &anErr.CustomError{
 				Message:    "결과 디코딩 오류",
@@ -116,8 +186,8 @@ func (us *UserServiceImpl) GetUser(id string) (*models.User, error) {
 	return users, nil
 }
 
-func (us *UserServiceImpl) CreateUser(dto *dto.UserCreateDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (us *UserServiceImpl) CreateUser(ctx context.Context, dto *dto.UserCreateDTO) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	fmt.Printf("dto: %+v", dto)
@@ -127,8 +197,6 @@ func (us *UserServiceImpl) CreateUser(dto *dto.UserCreateDTO) error {
 		GoogleID:  dto.GoogleID,
 		Email:     dto.Email,
 		UserName:  dto.UserName,
-		Verified:  dto.Verified,
-		Provider:  dto.Provider,
 		Photo:     dto.Photo,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -146,23 +214,59 @@ func (us *UserServiceImpl) CreateUser(dto *dto.UserCreateDTO) error {
 		}
 	}
 
+	if err := recordChange(ctx, us.auditCollection, us.collection, models.AuditResourceUser, user.ID, nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (us *UserServiceImpl) UpsertUser(dto *dto.UserUpdateDTO) (*models.User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// UpsertUser 관리자가 유저 정보를 생성/수정, 인증 여부와 가입 경로는 바꿀 수 없음
+func (us *UserServiceImpl) UpsertUser(ctx context.Context, dto *dto.UserUpdateDTO) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return us.upsertUser(ctx, dto, bson.M{})
+}
+
+// UpsertGoogleUser Google 로그인 유저를 생성/수정, Google이 확인한 이메일 인증 여부를 반영
+func (us *UserServiceImpl) UpsertGoogleUser(ctx context.Context, dto *dto.UserUpdateDTO, verified bool) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	user := bson.M{
+		"provider": models.UserProviderGoogle,
+		"verified": verified,
+	}
+
+	// ADMIN_EMAILS에 있는 유저는 이메일이 인증된 경우에만 로그인 시 관리자로 지정
+	if verified && isAdminEmail(dto.Email) {
+		user["role"] = models.UserRoleAdmin
+	}
+
+	// 이메일 인증 전의 로컬 가입 계정에 Google로 로그인하면 남이 정한 비밀번호일 수 있으므로 제거
+	unverified := bson.M{"email": dto.Email, "provider": models.UserProviderLocal, "verified": false}
+	if _, err := us.collection.UpdateOne(ctx, unverified, bson.M{"$unset": bson.M{"password_hash": ""}}); err != nil {
+		return nil, &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return us.upsertUser(ctx, dto, user)
+}
+
+// upsertUser 이메일 기준으로 유저를 생성/수정, user에 dto 값을 더해 저장
+func (us *UserServiceImpl) upsertUser(ctx context.Context, dto *dto.UserUpdateDTO, user bson.M) (*models.User, error) {
 	var err error
 
 	if dto.Email == "" {
 		return nil, errors.New("email cannot be empty")
 	}
 
-	user := bson.M{
-		"email":      dto.Email,
-		"updated_at": time.Now(),
-	}
+	user["email"] = dto.Email
+	user["updated_at"] = time.Now()
 
 	if dto.GoogleID != "" {
 		user["google_id"] = dto.GoogleID
@@ -172,14 +276,6 @@ func (us *UserServiceImpl) UpsertUser(dto *dto.UserUpdateDTO) (*models.User, err
 		user["user_name"] = dto.UserName
 	}
 
-	if dto.Verified != nil {
-		user["verified"] = dto.Verified
-	}
-
-	if dto.Provider != "" {
-		user["provider"] = dto.Provider
-	}
-
 	if dto.Photo != "" {
 		user["photo"] = dto.Photo
 	}
@@ -196,7 +292,14 @@ func (us *UserServiceImpl) UpsertUser(dto *dto.UserUpdateDTO) (*models.User, err
 
 	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(1)
 	query := bson.D{{Key: "email", Value: dto.Email}}
-	update := bson.D{{Key: "$set", Value: user}, {Key: "$setOnInsert", Value: bson.M{"create_at": time.Now()}}}
+
+	// 처음 로그인하면 before가 없으므로 생성으로 기록
+	var before bson.D
+	if err := us.collection.FindOne(ctx, query).Decode(&before); err != nil {
+		before = nil
+	}
+
+	update := bson.D{{Key: "$set", Value: user}, {Key: "$setOnInsert", Value: bson.M{"create_at": time.Now()}}, {Key: "$inc", Value: bson.M{"version": 1}}}
 	result := us.collection.FindOneAndUpdate(ctx, query, update, opts)
 
 	var updatedUser *models.User
@@ -209,5 +312,515 @@ func (us *UserServiceImpl) UpsertUser(dto *dto.UserUpdateDTO) (*models.User, err
 			Err:        err,
 		}
 	}
+
+	if err := recordChange(ctx, us.auditCollection, us.collection, models.AuditResourceUser, updatedUser.ID, before); err != nil {
+		return nil, err
+	}
+
 	return updatedUser, nil
 }
+
+func (us *UserServiceImpl) UpdateMe(ctx context.Context, userId string, dto *dto.UserProfileDTO) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	currentUserId, err := utils.ConvertToObjectId(userId)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	user := bson.M{
+		"updated_at": time.Now(),
+	}
+
+	if dto.UserName != "" {
+		user["user_name"] = dto.UserName
+	}
+
+	if dto.Position != "" {
+		user["position"] = dto.Position
+	}
+
+	if dto.Photo != "" {
+		user["photo"] = dto.Photo
+	}
+
+	if dto.Department != "" {
+		if user["department"], err = utils.ConvertToObjectId(dto.Department); err != nil {
+			return nil, utils.ConvertError("Department", err)
+		}
+	}
+
+	return us.updateUser(ctx, currentUserId, bson.M{"$set": user})
+}
+
+func (us *UserServiceImpl) DeactivateUser(ctx context.Context, id string, actorId string) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	userId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	if userId.Hex() == actorId {
+		return nil, &anErr.CustomError{
+			Message:    "자기 자신은 비활성화할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("user %s cannot deactivate itself", actorId),
+		}
+	}
+
+	update := bson.M{"$set": bson.M{"deactivated_at": time.Now(), "updated_at": time.Now()}}
+
+	return us.updateUser(ctx, userId, update)
+}
+
+func (us *UserServiceImpl) ReactivateUser(ctx context.Context, id string) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	userId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return nil, utils.ConvertError("User", err)
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"deactivated_at": ""},
+	}
+
+	return us.updateUser(ctx, userId, update)
+}
+
+func (us *UserServiceImpl) DeleteUser(ctx context.Context, id string, query *dto.UserDeleteDTO, actorId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	userId, err := utils.ConvertToObjectId(id)
+	if err != nil {
+		return utils.ConvertError("User", err)
+	}
+
+	if userId.Hex() == actorId {
+		return &anErr.CustomError{
+			Message:    "자기 자신은 삭제할 수 없음",
+			StatusCode: http.StatusBadRequest,
+			Err:        fmt.Errorf("user %s cannot delete itself", actorId),
+		}
+	}
+
+	if _, err := us.findUser(ctx, userId); err != nil {
+		return err
+	}
+
+	var reassignTo primitive.ObjectID
+	if query.ReassignTo != "" {
+		if reassignTo, err = utils.ConvertToObjectId(query.ReassignTo); err != nil {
+			return utils.ConvertError("User", err)
+		}
+
+		target, err := us.findUser(ctx, reassignTo)
+		if err != nil {
+			return err
+		}
+
+		if target.ID == userId || !target.IsActive() {
+			return &anErr.CustomError{
+				Message:    "삭제할 유저나 비활성화된 유저에게는 넘길 수 없음",
+				StatusCode: http.StatusBadRequest,
+				Err:        fmt.Errorf("cannot reassign to user %s", reassignTo.Hex()),
+			}
+		}
+	}
+
+	// 넘겨받을 유저가 없으면 소유한 Project의 소유자가 없어지므로 삭제하지 않음
+	if reassignTo.IsZero() {
+		owned, err := us.projectCollection.CountDocuments(ctx, bson.M{"owner": userId})
+		if err != nil {
+			return &anErr.CustomError{
+				Message:    "내부 서버 오류",
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if owned > 0 {
+			return &anErr.CustomError{
+				Message:    "소유한 Project가 있어 넘겨받을 유저(reassign_to)를 지정해야 함",
+				StatusCode: http.StatusConflict,
+				Err:        fmt.Errorf("user %s owns %d projects", userId.Hex(), owned),
+			}
+		}
+	}
+
+	before := auditSnapshot(ctx, us.collection, userId)
+	batches := us.userDataBatches(ctx, userId)
+
+	session, err := us.collection.Database().Client().StartSession()
+	if err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if reassignTo.IsZero() {
+			return nil, us.removeUserData(sessCtx, userId)
+		}
+		return nil, us.reassignUserData(sessCtx, userId, reassignTo)
+	})
+
+	if err != nil {
+		return &anErr.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	for _, batch := range batches {
+		if err := batch.record(ctx, us.auditCollection); err != nil {
+			return err
+		}
+	}
+
+	if err := recordAudit(ctx, us.auditCollection, models.AuditResourceUser, models.AuditActionDelete, userId, before, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// userDataBatches 유저를 삭제할 때 넘기거나 지울 문서들의 변경 전 스냅샷
+func (us *UserServiceImpl) userDataBatches(ctx context.Context, userId primitive.ObjectID) []*auditBatch {
+	return []*auditBatch{
+		newAuditBatch(ctx, us.noteCollection, models.AuditResourceNote, bson.M{"author": userId}),
+		newAuditBatch(ctx, us.todoCollection, models.AuditResourceTodo, bson.M{"user": userId}),
+		newAuditBatch(ctx, us.meetingCollection, models.AuditResourceMeeting, bson.M{"$or": bson.A{
+			bson.M{"created_by": userId},
+			bson.M{"participants.participant": userId},
+			bson.M{"minutes.action_items.assignee": userId},
+		}}),
+		newAuditBatch(ctx, us.projectTaskCollection, models.AuditResourceProjectTask, bson.M{"manager": userId}),
+		newAuditBatch(ctx, us.projectCollection, models.AuditResourceProject, bson.M{"$or": bson.A{
+			bson.M{"owner": userId},
+			bson.M{"members.user": userId},
+		}}),
+	}
+}
+
+// reassignUserData 유저의 Note, Todo, 생성한 Meeting, 담당 Project Task, 액션 아이템, 댓글, 작업 시간 기록과
+// Project 소유권/멤버 역할을 다른 유저에게 넘기고 유저를 삭제
+func (us *UserServiceImpl) reassignUserData(ctx mongo.SessionContext, userId primitive.ObjectID, reassignTo primitive.ObjectID) error {
+	reassignments := []struct {
+		collection *mongo.Collection
+		field      string
+	}{
+		{us.noteCollection, "author"},
+		{us.todoCollection, "user"},
+		{us.meetingCollection, "created_by"},
+		{us.projectTaskCollection, "manager"},
+		{us.commentCollection, "author"},
+	}
+
+	for _, reassignment := range reassignments {
+		filter := bson.M{reassignment.field: userId}
+		update := bumpVersion(bson.M{"$set": bson.M{reassignment.field: reassignTo}})
+		if _, err := reassignment.collection.UpdateMany(ctx, filter, update); err != nil {
+			return err
+		}
+	}
+
+	filter := bson.M{"minutes.action_items.assignee": userId}
+	update := bumpVersion(bson.M{"$set": bson.M{"minutes.action_items.$[a].assignee": reassignTo}})
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{bson.M{"a.assignee": userId}}})
+	if _, err := us.meetingCollection.UpdateMany(ctx, filter, update, opts); err != nil {
+		return err
+	}
+
+	// 유저당 실행 중인 타이머는 하나만 허용되므로 멈춘 뒤에 넘김
+	if err := us.stopTimer(ctx, userId); err != nil {
+		return err
+	}
+
+	if _, err := us.timeEntryCollection.UpdateMany(ctx, bson.M{"user": userId}, bumpVersion(bson.M{"$set": bson.M{"user": reassignTo}})); err != nil {
+		return err
+	}
+
+	if err := us.reassignProjects(ctx, userId, reassignTo); err != nil {
+		return err
+	}
+
+	return us.deleteUserDocument(ctx, userId)
+}
+
+// removeUserData 유저의 Note, Todo, 생성한 Meeting, 댓글, 작업 시간 기록을 삭제하고
+// 담당 Project Task와 액션 아이템은 담당자를 비우고 Project 멤버에서 뺀 뒤 유저를 삭제
+// Project Task는 Project에 속하므로 삭제하지 않음, 소유한 Project가 있으면 DeleteUser에서 거부
+func (us *UserServiceImpl) removeUserData(ctx mongo.SessionContext, userId primitive.ObjectID) error {
+	if _, err := us.noteCollection.DeleteMany(ctx, bson.M{"author": userId}); err != nil {
+		return err
+	}
+
+	if _, err := us.todoCollection.DeleteMany(ctx, bson.M{"user": userId}); err != nil {
+		return err
+	}
+
+	if _, err := us.meetingCollection.DeleteMany(ctx, bson.M{"created_by": userId}); err != nil {
+		return err
+	}
+
+	if _, err := us.projectTaskCollection.UpdateMany(ctx, bson.M{"manager": userId}, bumpVersion(bson.M{"$unset": bson.M{"manager": ""}})); err != nil {
+		return err
+	}
+
+	filter := bson.M{"minutes.action_items.assignee": userId}
+	update := bumpVersion(bson.M{"$unset": bson.M{"minutes.action_items.$[a].assignee": ""}})
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{bson.M{"a.assignee": userId}}})
+	if _, err := us.meetingCollection.UpdateMany(ctx, filter, update, opts); err != nil {
+		return err
+	}
+
+	// 삭제된 댓글의 멘션 알림도 함께 제거
+	commentIds, err := us.commentCollection.Distinct(ctx, "_id", bson.M{"author": userId})
+	if err != nil {
+		return err
+	}
+
+	if _, err := us.notificationCollection.DeleteMany(ctx, bson.M{"comment": bson.M{"$in": commentIds}}); err != nil {
+		return err
+	}
+
+	if _, err := us.commentCollection.DeleteMany(ctx, bson.M{"author": userId}); err != nil {
+		return err
+	}
+
+	if _, err := us.timeEntryCollection.DeleteMany(ctx, bson.M{"user": userId}); err != nil {
+		return err
+	}
+
+	if _, err := us.projectCollection.UpdateMany(ctx, bson.M{"members.user": userId}, bumpVersion(bson.M{"$pull": bson.M{"members": bson.M{"user": userId}}})); err != nil {
+		return err
+	}
+
+	return us.deleteUserDocument(ctx, userId)
+}
+
+// deleteUserDocument 다른 Meeting의 참석자 목록과 댓글 멘션에서 유저를 빼고 유저의 알림과 유저 문서를 삭제
+func (us *UserServiceImpl) deleteUserDocument(ctx mongo.SessionContext, userId primitive.ObjectID) error {
+	filter := bson.M{"participants.participant": userId}
+	update := bumpVersion(bson.M{"$pull": bson.M{"participants": bson.M{"participant": userId}}})
+	if _, err := us.meetingCollection.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+
+	if _, err := us.commentCollection.UpdateMany(ctx, bson.M{"mentions": userId}, bumpVersion(bson.M{"$pull": bson.M{"mentions": userId}})); err != nil {
+		return err
+	}
+
+	if _, err := us.notificationCollection.DeleteMany(ctx, bson.M{"user": userId}); err != nil {
+		return err
+	}
+
+	_, err := us.collection.DeleteOne(ctx, bson.M{"_id": userId})
+	return err
+}
+
+// reassignProjects 유저가 소유하거나 멤버인 Project에서 유저 자리를 넘겨받을 유저에게 넘김
+// 넘겨받을 유저가 이미 멤버이면 둘 중 높은 역할을 남김
+func (us *UserServiceImpl) reassignProjects(ctx mongo.SessionContext, userId primitive.ObjectID, reassignTo primitive.ObjectID) error {
+	var projects []models.Project
+
+	results, err := us.projectCollection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"owner": userId},
+		bson.M{"members.user": userId},
+	}})
+	if err != nil {
+		return err
+	}
+
+	defer results.Close(ctx)
+
+	if err := results.All(ctx, &projects); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, project := range projects {
+		role := project.MemberRole(userId)
+		if project.Owner == userId {
+			role = models.ProjectRoleOwner
+		}
+
+		members := []models.ProjectMember{}
+		found := false
+		for _, member := range project.Members {
+			if member.User == userId {
+				continue
+			}
+			if member.User == reassignTo {
+				found = true
+				if projectRoleRank[role] > projectRoleRank[member.Role] {
+					member.Role = role
+				}
+			}
+			members = append(members, member)
+		}
+
+		if !found {
+			members = append(members, models.ProjectMember{User: reassignTo, Role: role, AddedAt: now})
+		}
+
+		set := bson.M{"members": members, "updated_at": now}
+		if project.Owner == userId {
+			set["owner"] = reassignTo
+		}
+
+		if _, err := us.projectCollection.UpdateOne(ctx, bson.M{"_id": project.ID}, bumpVersion(bson.M{"$set": set})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopTimer 유저의 실행 중인 타이머를 멈춤
+func (us *UserServiceImpl) stopTimer(ctx mongo.SessionContext, userId primitive.ObjectID) error {
+	var entry models.TimeEntry
+
+	if err := us.timeEntryCollection.FindOne(ctx, bson.M{"user": userId, "running": true}).Decode(&entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	update := bumpVersion(bson.M{"$set": bson.M{
+		"end_dt":     now,
+		"minutes":    entryMinutes(entry.StartDt, now),
+		"running":    false,
+		"updated_at": now,
+	}})
+
+	_, err := us.timeEntryCollection.UpdateOne(ctx, bson.M{"_id": entry.ID, "running": true}, update)
+	return err
+}
+
+// findUser 유저를 조회
+func (us *UserServiceImpl) findUser(ctx context.Context, userId primitive.ObjectID) (*models.User, error) {
+	var user models.User
+
+	if err := us.collection.FindOne(ctx, bson.M{"_id": userId}).Decode(&user); err != nil {
+		return nil, findError("User를 찾을 수 없음", err)
+	}
+
+	return &user, nil
+}
+
+// updateUser 유저를 If-Match 조건으로 수정하고 수정된 유저를 반환
+func (us *UserServiceImpl) updateUser(ctx context.Context, userId primitive.ObjectID, update bson.M) (*models.User, error) {
+	var updatedUser models.User
+
+	before := auditSnapshot(ctx, us.collection, userId)
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := us.collection.FindOneAndUpdate(ctx, versionFilter(ctx, bson.M{"_id": userId}), bumpVersion(update), opts).Decode(&updatedUser); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, matchError(ctx, us.collection, userId, findError("User를 찾을 수 없음", err))
+		}
+		return nil, findError("User를 찾을 수 없음", err)
+	}
+
+	if err := recordChange(ctx, us.auditCollection, us.collection, models.AuditResourceUser, userId, before); err != nil {
+		return nil, err
+	}
+
+	return &updatedUser, nil
+}
+
+// isAdminEmail ADMIN_EMAILS(쉼표로 구분)에 있는 이메일인지 확인
+func isAdminEmail(email string) bool {
+	for _, adminEmail := range strings.Split(os.Getenv("ADMIN_EMAILS"), ",") {
+		if adminEmail = strings.TrimSpace(adminEmail); adminEmail != "" && strings.EqualFold(adminEmail, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// userFilter 검색어(이름/이메일 앞부분), 부서(하위 부서 포함), 직책 조건으로 유저 필터 생성
+func (us *UserServiceImpl) userFilter(ctx context.Context, query *dto.UserQueryDTO) (bson.M, error) {
+	filter := bson.M{}
+
+	if !query.IncludeDeactivated {
+		filter["deactivated_at"] = bson.M{"$exists": false}
+	}
+
+	if q := strings.TrimSpace(query.Q); q != "" {
+		prefix := regexp.QuoteMeta(q)
+		filter["$or"] = bson.A{
+			// 이름은 단어 단위로 앞부분 일치 ("Dae" → "Kim DaeHan")
+			bson.M{"user_name": primitive.Regex{Pattern: `(^|\s)` + prefix, Options: "i"}},
+			bson.M{"email": primitive.Regex{Pattern: "^" + prefix, Options: "i"}},
+		}
+	}
+
+	if query.Department != "" {
+		departmentId, err := utils.ConvertToObjectId(query.Department)
+		if err != nil {
+			return nil, utils.ConvertError("Department", err)
+		}
+
+		departments, err := subDepartments(ctx, us.collection.Database().Collection("departments"), departmentId)
+		if err != nil {
+			return nil, err
+		}
+
+		filter["department"] = bson.M{"$in": departments}
+	}
+
+	if query.Position != "" {
+		filter["position"] = query.Position
+	}
+
+	return filter, nil
+}
+
+// subDepartments 부서와 모든 하위 부서의 ID 목록
+func subDepartments(ctx context.Context, departmentCollection *mongo.Collection, departmentId primitive.ObjectID) ([]primitive.ObjectID, error) {
+	var result struct {
+		Descendants []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		} `bson:"descendants"`
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: departmentId}}}},
+		bson.D{{Key: "$graphLookup", Value: bson.D{
+			{Key: "from", Value: departmentCollection.Name()},
+			{Key: "startWith", Value: "$_id"},
+			{Key: "connectFromField", Value: "_id"},
+			{Key: "connectToField", Value: "parent_id"},
+			{Key: "as", Value: "descendants"},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{{Key: "descendants._id", Value: 1}}}},
+	}
+
+	if err := aggregateOne(ctx, departmentCollection, pipeline, &result); err != nil {
+		return nil, err
+	}
+
+	ids := []primitive.ObjectID{departmentId}
+	for _, department := range result.Descendants {
+		ids = append(ids, department.ID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,92 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/etag"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// version 필드는 수정할 때마다 1씩 증가, version이 없는 기존 문서는 0으로 취급
+// If-Match 헤더가 있으면 update 조건에 version을 넣어 다른 요청이 먼저 수정한 경우 412로 응답
+
+// versionFilter If-Match로 받은 version을 filter에 추가, 헤더가 없으면 filter를 그대로 반환
+func versionFilter(ctx context.Context, filter bson.M) bson.M {
+	versions, ok := etag.IfMatch(ctx)
+	if !ok {
+		return filter
+	}
+
+	condition := bson.A{}
+	for _, version := range versions {
+		condition = append(condition, version)
+		if version == 0 {
+			condition = append(condition, nil)
+		}
+	}
+
+	filter["version"] = bson.M{"$in": condition}
+	return filter
+}
+
+// bumpVersion update에 version 증가를 추가
+func bumpVersion(update bson.M) bson.M {
+	inc, _ := update["$inc"].(bson.M)
+	if inc == nil {
+		inc = bson.M{}
+	}
+	inc["version"] = 1
+	update["$inc"] = inc
+	return update
+}
+
+// matchError update 조건에 맞는 문서가 없을 때 version이 If-Match와 다르면 412, 그 외에는 notFound
+func matchError(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID, notFound error) error {
+	return scopedMatchError(ctx, collection, bson.M{"_id": id}, notFound)
+}
+
+// scopedMatchError matchError와 같으나 본인 문서 등 filter에 맞는 문서의 version만 확인
+// 다른 유저의 문서에 대해 412로 존재가 드러나지 않도록 함
+func scopedMatchError(ctx context.Context, collection *mongo.Collection, filter bson.M, notFound error) error {
+	versions, ok := etag.IfMatch(ctx)
+	if !ok {
+		return notFound
+	}
+
+	var current struct {
+		Version int64 `bson:"version"`
+	}
+
+	filter["deleted_at"] = nil
+	if err := collection.FindOne(ctx, filter).Decode(&current); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return notFound
+		}
+		return &errors.CustomError{
+			Message:    "내부 서버 오류",
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	// version은 맞지만 다른 조건(참석자 등)이 맞지 않은 경우
+	if slices.Contains(versions, current.Version) {
+		return notFound
+	}
+
+	return versionConflict(current.Version)
+}
+
+func versionConflict(current int64) error {
+	return &errors.CustomError{
+		Message:    "다른 요청이 먼저 수정하여 다시 조회 후 수정해야 함",
+		StatusCode: http.StatusPreconditionFailed,
+		Err:        fmt.Errorf("version mismatch, current version is %d", current),
+	}
+}
@@ -0,0 +1,107 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Kim-DaeHan/all-note-golang/errors"
+	"github.com/Kim-DaeHan/all-note-golang/etag"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// versionMatches versionFilter가 만든 조건이 문서의 version 값과 맞는지 확인, version이 없는 문서는 nil
+func versionMatches(filter bson.M, version interface{}) bool {
+	condition, ok := filter["version"].(bson.M)
+	if !ok {
+		return true
+	}
+	for _, v := range condition["$in"].(bson.A) {
+		if reflect.DeepEqual(v, version) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestVersionFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifMatch  []int64
+		version  interface{}
+		want     bool
+		noFilter bool
+	}{
+		{name: "no header", version: int64(5), want: true, noFilter: true},
+		{name: "matching version", ifMatch: []int64{5}, version: int64(5), want: true},
+		{name: "stale version", ifMatch: []int64{4}, version: int64(5), want: false},
+		{name: "one of several versions", ifMatch: []int64{3, 5}, version: int64(5), want: true},
+		{name: "legacy document without version", ifMatch: []int64{0}, version: nil, want: true},
+		{name: "legacy document with explicit zero", ifMatch: []int64{0}, version: int64(0), want: true},
+		{name: "legacy document with stale header", ifMatch: []int64{1}, version: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ifMatch != nil {
+				ctx = etag.WithIfMatch(ctx, tt.ifMatch)
+			}
+
+			filter := versionFilter(ctx, bson.M{"_id": "id"})
+
+			if filter["_id"] != "id" {
+				t.Errorf("original condition lost: %v", filter)
+			}
+			if _, ok := filter["version"]; ok == tt.noFilter {
+				t.Errorf("version condition present %v, want %v", ok, !tt.noFilter)
+			}
+			if got := versionMatches(filter, tt.version); got != tt.want {
+				t.Errorf("matches %v: got %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBumpVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		update bson.M
+		want   bson.M
+	}{
+		{
+			name:   "set only",
+			update: bson.M{"$set": bson.M{"title": "a"}},
+			want:   bson.M{"$set": bson.M{"title": "a"}, "$inc": bson.M{"version": 1}},
+		},
+		{
+			name:   "keeps existing inc",
+			update: bson.M{"$inc": bson.M{"comment_count": 1}},
+			want:   bson.M{"$inc": bson.M{"comment_count": 1, "version": 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bumpVersion(tt.update); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionConflict(t *testing.T) {
+	customErr, ok := versionConflict(7).(*errors.CustomError)
+	if !ok || customErr.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("got %v, want 412", customErr)
+	}
+}
+
+func TestMatchErrorWithoutIfMatch(t *testing.T) {
+	// If-Match가 없으면 version을 확인하지 않으므로 DB 조회 없이 notFound를 그대로 반환
+	notFound := &errors.CustomError{Message: "없음", StatusCode: http.StatusNotFound}
+	if err := scopedMatchError(context.Background(), nil, bson.M{}, notFound); err != notFound {
+		t.Errorf("got %v, want notFound", err)
+	}
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
@@ -9,7 +10,12 @@ type JobApplicationService interface {
 	GetAllJobApplication() ([]models.JobApplication, error)
 	GetJobApplication(id string) (*models.JobApplication, error)
 	GetJobApplicationByManager(userId string) ([]models.JobApplication, error)
-	CreateJobApplication(dto *dto.JobApplicationCreateDTO) error
-	UpdateJobApplication(id string, dto *dto.JobApplicationUpdateDTO) (*models.JobApplication, error)
-	DeleteJobApplication(id string) error
+	CreateJobApplication(ctx context.Context, dto *dto.JobApplicationCreateDTO) error
+	GetJobApplicationStageDurations(id string) ([]models.StageDuration, error)
+	GetRecruitingFunnel(query *dto.RecruitingFunnelQueryDTO) (*models.RecruitingFunnel, error)
+	UpdateJobApplication(ctx context.Context, id string, dto *dto.JobApplicationUpdateDTO, userId string) (*models.JobApplication, error)
+	DeleteJobApplication(ctx context.Context, id string) error
+	GetJobApplicationInterviews(id string) ([]models.Meeting, error)
+	ScheduleInterview(ctx context.Context, id string, dto *dto.InterviewCreateDTO, userId string) (*models.Meeting, error)
+	UpdateInterviewOutcome(ctx context.Context, id string, meetingId string, dto *dto.InterviewOutcomeDTO, userId string) (*models.Meeting, error)
 }
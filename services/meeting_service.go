@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
@@ -8,8 +9,14 @@ import (
 type MeetingService interface {
 	GetAllMeeting() ([]models.Meeting, error)
 	GetMeeting(id string) (*models.Meeting, error)
-	GetMeetingByUser(userId string) ([]models.Meeting, error)
-	CreateMeeting(dto *dto.MeetingCreateDTO) error
-	UpdateMeeting(id string, dto *dto.MeetingUpdateDTO) (*models.Meeting, error)
-	DeleteMeeting(id string) error
+	GetMeetingByUser(userId string, query *dto.MeetingQueryDTO) ([]models.Meeting, error)
+	GetMeetingCalendar(query *dto.MeetingQueryDTO) ([]models.Meeting, error)
+	CreateMeeting(ctx context.Context, dto *dto.MeetingCreateDTO) error
+	UpdateMeeting(ctx context.Context, id string, dto *dto.MeetingUpdateDTO) (*models.Meeting, error)
+	DeleteMeeting(ctx context.Context, id string) error
+	RespondMeeting(ctx context.Context, id string, dto *dto.MeetingRSVPDTO, userId string) (*models.Meeting, error)
+	MarkAttendance(ctx context.Context, id string, dto *dto.MeetingAttendanceDTO, userId string) (*models.Meeting, error)
+	UpdateMeetingOccurrence(ctx context.Context, id string, dto *dto.MeetingOccurrenceDTO) (*models.Meeting, error)
+	UpdateMeetingMinutes(ctx context.Context, id string, dto *dto.MeetingMinutesDTO) (*models.Meeting, error)
+	ConvertActionItemsToTodos(ctx context.Context, id string) ([]models.Todo, error)
 }
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
@@ -9,7 +10,7 @@ type NoteService interface {
 	GetAllNote() ([]models.Note, error)
 	GetNote(id string) (*models.Note, error)
 	GetNoteByUser(userId string) ([]models.Note, error)
-	CreateNote(dto *dto.NoteCreateDTO) error
-	UpdateNote(id string, dto *dto.NoteUpdateDTO) (*models.Note, error)
-	DeleteNote(id string) error
+	CreateNote(ctx context.Context, dto *dto.NoteCreateDTO) error
+	UpdateNote(ctx context.Context, id string, dto *dto.NoteUpdateDTO) (*models.Note, error)
+	DeleteNote(ctx context.Context, id string) error
 }
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type NotificationService interface {
+	GetNotificationByUser(query *dto.NotificationQueryDTO, userId string) ([]models.Notification, error)
+	ReadNotification(id string, userId string) error
+	ReadAllNotification(userId string) error
+}
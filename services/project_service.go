@@ -1,13 +1,24 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
 
 type ProjectService interface {
-	GetAllProject() ([]models.Project, error)
-	CreateProject(dto *dto.ProjectCreateDTO) error
-	UpdateProject(id string, dto *dto.ProjectUpdateDTO) (*models.Project, error)
-	DeleteProject(id string) error
+	GetAllProject(query *dto.ProjectQueryDTO, userId string) ([]models.Project, error)
+	GetProject(id string, userId string) (*models.ProjectDetail, error)
+	CreateProject(ctx context.Context, dto *dto.ProjectCreateDTO, userId string) error
+	UpdateProject(ctx context.Context, id string, dto *dto.ProjectUpdateDTO, userId string) (*models.Project, error)
+	DeleteProject(ctx context.Context, id string, mode string, userId string) error
+	ArchiveProject(ctx context.Context, id string, userId string) (*models.Project, error)
+	UnarchiveProject(ctx context.Context, id string, userId string) (*models.Project, error)
+	AddProjectMember(ctx context.Context, id string, dto *dto.ProjectMemberDTO, userId string) (*models.Project, error)
+	RemoveProjectMember(ctx context.Context, id string, memberId string, userId string) (*models.Project, error)
+	AddProjectMilestone(ctx context.Context, id string, dto *dto.ProjectMilestoneDTO, userId string) (*models.Project, error)
+	UpdateProjectMilestone(ctx context.Context, id string, milestoneId string, dto *dto.ProjectMilestoneDTO, userId string) (*models.Project, error)
+	DeleteProjectMilestone(ctx context.Context, id string, milestoneId string, userId string) (*models.Project, error)
+	GetProjectTimeline(id string, userId string) (*models.ProjectTimeline, error)
+	GetProjectCriticalPath(id string, userId string) (*models.ProjectCriticalPath, error)
 }
@@ -1,14 +1,15 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
 
 type ProjectTaskService interface {
-	GetProjectTask(id string) (*models.ProjectTask, error)
-	GetProjectTaskByProject(userId string) ([]models.ProjectTask, error)
-	CreateProjectTask(dto *dto.ProjectTaskCreateDTO) error
-	UpdateProjectTask(id string, dto *dto.ProjectTaskUpdateDTO) (*models.ProjectTask, error)
-	DeleteProjectTask(id string) error
+	GetProjectTask(id string, userId string) (*models.ProjectTask, error)
+	GetProjectTaskByProject(projectId string, userId string) ([]models.ProjectTask, error)
+	CreateProjectTask(ctx context.Context, dto *dto.ProjectTaskCreateDTO, userId string) error
+	UpdateProjectTask(ctx context.Context, id string, dto *dto.ProjectTaskUpdateDTO, userId string) (*models.ProjectTask, error)
+	DeleteProjectTask(ctx context.Context, id string, userId string) error
 }
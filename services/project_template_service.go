@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type ProjectTemplateService interface {
+	GetAllProjectTemplate() ([]models.ProjectTemplate, error)
+	GetProjectTemplate(id string) (*models.ProjectTemplate, error)
+	CreateProjectTemplate(dto *dto.ProjectTemplateCreateDTO, userId string) (*models.ProjectTemplate, error)
+	InstantiateProjectTemplate(ctx context.Context, id string, dto *dto.ProjectTemplateInstantiateDTO, userId string) (*models.Project, error)
+	DeleteProjectTemplate(id string, userId string) error
+}
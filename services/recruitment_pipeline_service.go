@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type RecruitmentPipelineService interface {
+	GetAllRecruitmentPipeline() ([]models.RecruitmentPipeline, error)
+	GetRecruitmentPipeline(id string) (*models.RecruitmentPipeline, error)
+	GetRecruitmentPipelineMetrics(id string) ([]models.StageMetric, error)
+	CreateRecruitmentPipeline(dto *dto.RecruitmentPipelineCreateDTO) (*models.RecruitmentPipeline, error)
+	UpdateRecruitmentPipeline(ctx context.Context, id string, dto *dto.RecruitmentPipelineUpdateDTO) (*models.RecruitmentPipeline, error)
+	DeleteRecruitmentPipeline(ctx context.Context, id string) error
+}
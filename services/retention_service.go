@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type RetentionService interface {
+	GetAllRetentionPolicy() ([]models.RetentionPolicy, error)
+	SetRetentionPolicy(outcome string, dto *dto.RetentionPolicyDTO) (*models.RetentionPolicy, error)
+	DeleteRetentionPolicy(outcome string) error
+	GetErasureLogs(query *dto.ErasureLogQueryDTO) ([]models.ErasureLog, error)
+	EraseCandidate(ctx context.Context, id string, userId string) (*models.RetentionRunResult, error)
+	ApplyRetentionPolicies(ctx context.Context) (*models.RetentionRunResult, error)
+}
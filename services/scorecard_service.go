@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type ScorecardService interface {
+	GetScorecardByJobApplication(id string, userId string) ([]models.Scorecard, error)
+	GetScorecardSummary(id string, userId string) (*models.ScorecardSummary, error)
+	SubmitScorecard(id string, dto *dto.ScorecardSubmitDTO, userId string) (*models.Scorecard, error)
+	DecideJobApplication(ctx context.Context, id string, dto *dto.HiringDecisionDTO, userId string, isAdmin bool) (*models.JobApplication, error)
+}
@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type ScorecardTemplateService interface {
+	GetAllScorecardTemplate() ([]models.ScorecardTemplate, error)
+	GetScorecardTemplate(id string) (*models.ScorecardTemplate, error)
+	CreateScorecardTemplate(dto *dto.ScorecardTemplateCreateDTO) (*models.ScorecardTemplate, error)
+	UpdateScorecardTemplate(ctx context.Context, id string, dto *dto.ScorecardTemplateUpdateDTO) (*models.ScorecardTemplate, error)
+	DeleteScorecardTemplate(ctx context.Context, id string) error
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type TimeEntryService interface {
+	GetTimeEntryByUser(userId string, query *dto.TimeEntryQueryDTO) ([]models.TimeEntry, error)
+	StartTimer(dto *dto.TimeEntryStartDTO, userId string) (*models.TimeEntry, error)
+	StopTimer(userId string) (*models.TimeEntry, error)
+	CreateTimeEntry(dto *dto.TimeEntryCreateDTO, userId string) (*models.TimeEntry, error)
+	DeleteTimeEntry(ctx context.Context, id string, userId string) error
+	GetTimeReport(query *dto.TimeReportQueryDTO, userId string) ([]models.TimeReportRow, error)
+	GetProjectTimeEstimates(projectId string, userId string) ([]models.TimeEstimate, error)
+}
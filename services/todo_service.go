@@ -1,15 +1,16 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
 
 type TodoService interface {
-	GetAllTodo() ([]models.Todo, error)
-	GetTodo(id string) (*models.Todo, error)
-	GetTodoByUser(userId string) ([]models.Todo, error)
-	CreateTodo(dto *dto.TodoCreateDTO) error
-	UpdateTodo(id string, dto *dto.TodoUpdateDTO) (*models.Todo, error)
-	DeleteTodo(id string) error
+	GetAllTodo(userId string) ([]models.Todo, error)
+	GetTodo(id string, userId string) (*models.Todo, error)
+	GetTodoByUser(userId string, actor string) ([]models.Todo, error)
+	CreateTodo(ctx context.Context, dto *dto.TodoCreateDTO, userId string) error
+	UpdateTodo(ctx context.Context, id string, dto *dto.TodoUpdateDTO, userId string) (*models.Todo, error)
+	DeleteTodo(ctx context.Context, id string, userId string) error
 }
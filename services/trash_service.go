@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Kim-DaeHan/all-note-golang/dto"
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+type TrashService interface {
+	GetTrash(query *dto.TrashQueryDTO, userId string) ([]models.TrashItem, error)
+	RestoreTrashItem(ctx context.Context, resource string, id string, userId string) error
+	PurgeTrash() (*models.TrashPurgeResult, error)
+}
@@ -1,13 +1,20 @@
 package services
 
 import (
+	"context"
 	"github.com/Kim-DaeHan/all-note-golang/dto"
 	"github.com/Kim-DaeHan/all-note-golang/models"
 )
 
 type UserService interface {
-	GetAllUser() ([]models.User, error)
+	GetAllUser(query *dto.UserQueryDTO) ([]models.User, error)
+	SearchUsers(query *dto.UserQueryDTO) ([]models.UserSummary, error)
 	GetUser(id string) (*models.User, error)
-	CreateUser(dto *dto.UserCreateDTO) error
-	UpsertUser(dto *dto.UserUpdateDTO) (*models.User, error)
+	CreateUser(ctx context.Context, dto *dto.UserCreateDTO) error
+	UpsertUser(ctx context.Context, dto *dto.UserUpdateDTO) (*models.User, error)
+	UpsertGoogleUser(ctx context.Context, dto *dto.UserUpdateDTO, verified bool) (*models.User, error)
+	UpdateMe(ctx context.Context, userId string, dto *dto.UserProfileDTO) (*models.User, error)
+	DeactivateUser(ctx context.Context, id string, actorId string) (*models.User, error)
+	ReactivateUser(ctx context.Context, id string) (*models.User, error)
+	DeleteUser(ctx context.Context, id string, query *dto.UserDeleteDTO, actorId string) error
 }
@@ -0,0 +1,22 @@
+package utils
+
+import "regexp"
+
+// 공백이나 문장 시작 뒤의 @이름 (한글 이름 포함, 이메일 주소의 @는 제외)
+var mentionPattern = regexp.MustCompile(`(?:^|[\s(\[{,])@([\p{L}\p{N}_.\-]+)`)
+
+// ParseMentions returns the unique @mentioned names in content, in order of first appearance.
+func ParseMentions(content string) []string {
+	names := []string{}
+	seen := map[string]bool{}
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		name := match[1]
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
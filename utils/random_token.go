@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// NewRandomToken 메일 링크 등에 쓰는 임의 토큰과 저장용 해시를 생성
+func NewRandomToken() (token string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken 토큰의 sha256 해시, DB에는 원문 대신 해시만 저장
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
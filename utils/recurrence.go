@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+// 규칙이 잘못되었거나 종료 조건이 없을 때 무한 반복을 막기 위한 최대 반복 횟수
+const maxRecurrenceIterations = 10000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// IsValidWeekday checks if the day is one of SU, MO, TU, WE, TH, FR, SA.
+func IsValidWeekday(day string) bool {
+	_, ok := weekdays[day]
+	return ok
+}
+
+// ExpandRecurrence returns the start times of a recurring series, beginning at start, that fall within [from, to).
+func ExpandRecurrence(rule *models.Recurrence, start, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+
+	// 요일과 날짜는 반복 규칙의 타임존 기준으로 계산
+	if rule.TimeZone != "" {
+		if loc, err := time.LoadLocation(rule.TimeZone); err == nil {
+			start = start.In(loc)
+		}
+	}
+
+	interval := rule.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	count := 0
+
+	// 후보 일시를 순서대로 받아 종료 조건을 확인하고, 계속 진행할지 여부를 반환
+	emit := func(t time.Time) bool {
+		if t.Before(start) {
+			return true
+		}
+		if rule.Until != nil && t.After(*rule.Until) {
+			return false
+		}
+		if rule.Count > 0 && count >= rule.Count {
+			return false
+		}
+		if !t.Before(to) {
+			return false
+		}
+
+		count++
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return true
+	}
+
+	switch rule.Freq {
+	case models.FreqDaily:
+		for i := 0; i < maxRecurrenceIterations; i++ {
+			if !emit(start.AddDate(0, 0, i*interval)) {
+				break
+			}
+		}
+
+	case models.FreqWeekly:
+		if len(rule.ByDay) == 0 {
+			for i := 0; i < maxRecurrenceIterations; i++ {
+				if !emit(start.AddDate(0, 0, i*7*interval)) {
+					break
+				}
+			}
+			break
+		}
+
+		// 월요일을 한 주의 시작으로 보고 요일별 오프셋 계산, 중복된 요일은 한 번만 반영
+		offsets := make([]int, 0, len(rule.ByDay))
+		seen := make(map[int]bool, len(rule.ByDay))
+		for _, day := range rule.ByDay {
+			weekday, ok := weekdays[day]
+			if !ok {
+				continue
+			}
+			offset := (int(weekday) + 6) % 7
+			if !seen[offset] {
+				seen[offset] = true
+				offsets = append(offsets, offset)
+			}
+		}
+		sort.Ints(offsets)
+
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+
+	weeks:
+		for i := 0; i < maxRecurrenceIterations; i++ {
+			week := weekStart.AddDate(0, 0, i*7*interval)
+			for _, offset := range offsets {
+				if !emit(week.AddDate(0, 0, offset)) {
+					break weeks
+				}
+			}
+		}
+
+	case models.FreqMonthly:
+		for i := 0; i < maxRecurrenceIterations; i++ {
+			t := start.AddDate(0, i*interval, 0)
+			// 31일처럼 해당 월에 없는 날짜는 건너뜀
+			if t.Day() != start.Day() {
+				continue
+			}
+			if !emit(t) {
+				break
+			}
+		}
+	}
+
+	return occurrences
+}
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kim-DaeHan/all-note-golang/models"
+)
+
+func TestExpandRecurrence(t *testing.T) {
+	// 2024-01-01은 월요일
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 9, 0, 0, 0, time.UTC)
+	}
+	until := day(10)
+
+	tests := []struct {
+		name     string
+		rule     models.Recurrence
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name: "daily",
+			rule: models.Recurrence{Freq: models.FreqDaily},
+			from: day(1), to: day(4),
+			want: []time.Time{day(1), day(2), day(3)},
+		},
+		{
+			name: "daily with interval",
+			rule: models.Recurrence{Freq: models.FreqDaily, Interval: 2},
+			from: day(1), to: day(8),
+			want: []time.Time{day(1), day(3), day(5), day(7)},
+		},
+		{
+			name: "weekly by day",
+			rule: models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"FR", "MO", "WE"}},
+			from: day(1), to: day(8),
+			want: []time.Time{day(1), day(3), day(5)},
+		},
+		{
+			name: "weekly by day with duplicates",
+			rule: models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"MO", "WE", "MO", "WE"}, Count: 3},
+			from: day(1), to: day(31),
+			want: []time.Time{day(1), day(3), day(8)},
+		},
+		{
+			name: "weekly by day skips days before start",
+			rule: models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"SU", "TU"}},
+			from: day(1), to: day(10),
+			want: []time.Time{day(2), day(7), day(9)},
+		},
+		{
+			name: "weekly with interval",
+			rule: models.Recurrence{Freq: models.FreqWeekly, Interval: 2},
+			from: day(1), to: day(31),
+			want: []time.Time{day(1), day(15), day(29)},
+		},
+		{
+			name: "count",
+			rule: models.Recurrence{Freq: models.FreqDaily, Count: 3},
+			from: day(1), to: day(31),
+			want: []time.Time{day(1), day(2), day(3)},
+		},
+		{
+			name: "count is consumed by occurrences before from",
+			rule: models.Recurrence{Freq: models.FreqDaily, Count: 3},
+			from: day(3), to: day(31),
+			want: []time.Time{day(3)},
+		},
+		{
+			name: "until is inclusive",
+			rule: models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"MO", "WE"}, Until: &until},
+			from: day(1), to: day(31),
+			want: []time.Time{day(1), day(3), day(8), day(10)},
+		},
+		{
+			name: "to is exclusive",
+			rule: models.Recurrence{Freq: models.FreqDaily},
+			from: day(2), to: day(3),
+			want: []time.Time{day(2)},
+		},
+		{
+			name: "monthly skips missing days",
+			rule: models.Recurrence{Freq: models.FreqMonthly},
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seriesStart := start
+			if tt.rule.Freq == models.FreqMonthly {
+				seriesStart = time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+			}
+
+			got := ExpandRecurrence(&tt.rule, seriesStart, tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d: got %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestExpandRecurrenceTimeZone(t *testing.T) {
+	// 서울 기준 월요일 오전 8시는 UTC로 일요일 23시
+	start := time.Date(2024, 1, 7, 23, 0, 0, 0, time.UTC)
+	rule := models.Recurrence{Freq: models.FreqWeekly, ByDay: []string{"MO"}, TimeZone: "Asia/Seoul", Count: 2}
+
+	got := ExpandRecurrence(&rule, start, start, start.AddDate(0, 1, 0))
+	want := []time.Time{start, start.AddDate(0, 0, 7)}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
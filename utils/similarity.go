@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeName lowercases name and collapses whitespace so names can be compared.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// NormalizeEmail lowercases and trims an email address.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone keeps only the digits of a phone number.
+func NormalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// Similarity returns 1 - (Levenshtein distance / longer length) over runes, in [0, 1].
+func Similarity(a string, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	longer := len(ra)
+	if len(rb) > longer {
+		longer = len(rb)
+	}
+
+	return 1 - float64(prev[len(rb)])/float64(longer)
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 부록 B의 SHA1 비밀키 "12345678901234567890" (base32)
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 부록 B의 8자리 코드 중 뒤 6자리
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			got, err := TOTPCode(rfc6238Secret, TOTPStep(time.Unix(tt.unix, 0)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTOTPCodeLowercaseSecret(t *testing.T) {
+	got, err := TOTPCode("gezdgnbvgy3tqojqgezdgnbvgy3tqojq", TOTPStep(time.Unix(59, 0)))
+	if err != nil || got != "287082" {
+		t.Errorf("got %s, %v, want 287082", got, err)
+	}
+
+	if _, err := TOTPCode("not base32!", 1); err == nil {
+		t.Error("invalid secret: want error")
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := TOTPStep(now)
+
+	code := func(step int64) string {
+		c, err := TOTPCode(rfc6238Secret, step)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		code      string
+		afterStep int64
+		wantStep  int64
+		wantOK    bool
+	}{
+		{name: "current step", code: code(current), wantStep: current, wantOK: true},
+		{name: "previous step", code: code(current - 1), wantStep: current - 1, wantOK: true},
+		{name: "next step", code: code(current + 1), wantStep: current + 1, wantOK: true},
+		{name: "two steps ago", code: code(current - 2), wantOK: false},
+		{name: "two steps ahead", code: code(current + 2), wantOK: false},
+		{name: "surrounding spaces", code: " " + code(current) + " ", wantStep: current, wantOK: true},
+		{name: "wrong length", code: code(current)[:5], wantOK: false},
+		{name: "wrong code", code: "000000", wantOK: code(current) == "000000"},
+		{name: "replay of used step", code: code(current), afterStep: current, wantOK: false},
+		{name: "replay of earlier step", code: code(current - 1), afterStep: current - 1, wantOK: false},
+		{name: "later step after earlier use", code: code(current), afterStep: current - 1, wantStep: current, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(rfc6238Secret, tt.code, now, tt.afterStep)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != tt.wantStep {
+				t.Errorf("got step %d, want %d", step, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	got := TOTPURI("All Note", "user@example.com", rfc6238Secret)
+	want := "otpauth://totp/All%20Note:user@example.com?algorithm=SHA1&digits=6&issuer=All%20Note&period=30&secret=" + rfc6238Secret
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}